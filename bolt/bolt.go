@@ -0,0 +1,360 @@
+// Package bolt provides file-backed implementations of the domain
+// repositories, stored in an embedded bbolt database.
+package bolt
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+var (
+	cargoBucket         = []byte("cargos")
+	handlingEventBucket = []byte("handling_events")
+	locationBucket      = []byte("locations")
+	voyageBucket        = []byte("voyages")
+)
+
+// Open opens (creating it if necessary) the bbolt database at path.
+func Open(path string) (*bolt.DB, error) {
+	return bolt.Open(path, 0600, nil)
+}
+
+func createBucket(db *bolt.DB, name []byte) error {
+	return db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(name)
+		return err
+	})
+}
+
+type cargoRepository struct {
+	db     *bolt.DB
+	logger log.Logger
+}
+
+func (r *cargoRepository) Store(c *cargo.Cargo) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(cargoBucket)
+
+		var current cargo.Cargo
+		if v := bucket.Get([]byte(c.TrackingID)); v != nil {
+			if err := json.Unmarshal(v, &current); err != nil {
+				return err
+			}
+		}
+		if current.Version != c.Version {
+			return &cargo.ConflictError{TrackingID: c.TrackingID, Expected: c.Version, Actual: current.Version}
+		}
+
+		stored := *c
+		stored.Version++
+		b, err := json.Marshal(&stored)
+		if err != nil {
+			return err
+		}
+		return bucket.Put([]byte(c.TrackingID), b)
+	})
+	if err != nil {
+		return err
+	}
+	c.Version++
+	return nil
+}
+
+func (r *cargoRepository) Find(id cargo.TrackingID) (*cargo.Cargo, error) {
+	var c *cargo.Cargo
+	err := r.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(cargoBucket).Get([]byte(id))
+		if v == nil {
+			return cargo.ErrUnknown
+		}
+		c = new(cargo.Cargo)
+		return json.Unmarshal(v, c)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *cargoRepository) FindAll() []*cargo.Cargo {
+	c := make([]*cargo.Cargo, 0)
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(cargoBucket).ForEach(func(_, v []byte) error {
+			var val cargo.Cargo
+			if err := json.Unmarshal(v, &val); err != nil {
+				return err
+			}
+			c = append(c, &val)
+			return nil
+		})
+	})
+	if err != nil {
+		r.logger.Log("method", "find_all", "err", err)
+		return make([]*cargo.Cargo, 0)
+	}
+	return c
+}
+
+// NewCargoRepository returns a new instance of a bbolt cargo repository.
+// Errors that the repository interface cannot return are logged.
+func NewCargoRepository(db *bolt.DB, logger log.Logger) (cargo.Repository, error) {
+	if err := createBucket(db, cargoBucket); err != nil {
+		return nil, err
+	}
+	return &cargoRepository{db: db, logger: logger}, nil
+}
+
+type locationRepository struct {
+	db     *bolt.DB
+	logger log.Logger
+}
+
+func (r *locationRepository) Find(locode location.UNLcode) (*location.Location, error) {
+	var l *location.Location
+	err := r.db.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(locationBucket).Get([]byte(locode))
+		if v == nil {
+			return location.ErrUnknown
+		}
+		l = new(location.Location)
+		return json.Unmarshal(v, l)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (r *locationRepository) FindAll() []*location.Location {
+	l := make([]*location.Location, 0)
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(locationBucket).ForEach(func(_, v []byte) error {
+			var val location.Location
+			if err := json.Unmarshal(v, &val); err != nil {
+				return err
+			}
+			l = append(l, &val)
+			return nil
+		})
+	})
+	if err != nil {
+		r.logger.Log("method", "find_all", "err", err)
+		return make([]*location.Location, 0)
+	}
+	return l
+}
+
+func (r *locationRepository) Store(ls ...*location.Location) error {
+	return r.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(locationBucket)
+		for _, l := range ls {
+			v, err := json.Marshal(l)
+			if err != nil {
+				return err
+			}
+			if err := b.Put([]byte(l.UNLcode), v); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// NewLocationRepository returns a new instance of a bbolt location
+// repository. The sample locations are stored the first time the database is
+// opened. Errors that the repository interface cannot return are logged.
+func NewLocationRepository(db *bolt.DB, logger log.Logger) (location.Repository, error) {
+	if err := createBucket(db, locationBucket); err != nil {
+		return nil, err
+	}
+
+	r := &locationRepository{db: db, logger: logger}
+
+	if len(r.FindAll()) == 0 {
+		if err := r.Store(
+			location.Stockholm,
+			location.Melbourne,
+			location.Hongkong,
+			location.Tokyo,
+			location.Rotterdam,
+			location.Hamburg,
+			location.NewYork,
+			location.Chicago,
+			location.Helsinki,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+type voyageRepository struct {
+	db     *bolt.DB
+	logger log.Logger
+}
+
+func (r *voyageRepository) Find(voyageNumber voyage.Number) (*voyage.Voyage, error) {
+	var v *voyage.Voyage
+	err := r.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(voyageBucket).Get([]byte(voyageNumber))
+		if b == nil {
+			return voyage.ErrUnknown
+		}
+		v = new(voyage.Voyage)
+		return json.Unmarshal(b, v)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (r *voyageRepository) FindAll() []*voyage.Voyage {
+	v := make([]*voyage.Voyage, 0)
+	err := r.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(voyageBucket).ForEach(func(_, b []byte) error {
+			var val voyage.Voyage
+			if err := json.Unmarshal(b, &val); err != nil {
+				return err
+			}
+			v = append(v, &val)
+			return nil
+		})
+	})
+	if err != nil {
+		r.logger.Log("method", "find_all", "err", err)
+		return make([]*voyage.Voyage, 0)
+	}
+	return v
+}
+
+func (r *voyageRepository) Store(v *voyage.Voyage) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return r.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(voyageBucket).Put([]byte(v.Number), b)
+	})
+}
+
+// NewVoyageRepository returns a new instance of a bbolt voyage repository.
+// The sample voyages are stored when missing, and stored again once all of
+// their stored movements have departed, since their schedules are relative
+// to when the process started. Errors that the repository interface cannot
+// return are logged.
+func NewVoyageRepository(db *bolt.DB, logger log.Logger) (voyage.Repository, error) {
+	if err := createBucket(db, voyageBucket); err != nil {
+		return nil, err
+	}
+
+	r := &voyageRepository{db: db, logger: logger}
+
+	now := time.Now()
+	for _, v := range []*voyage.Voyage{
+		voyage.V100,
+		voyage.V300,
+		voyage.V400,
+		voyage.V0100S,
+		voyage.V0200T,
+		voyage.V0300A,
+		voyage.V0301S,
+		voyage.V0400S,
+	} {
+		stored, err := r.Find(v.Number)
+		switch {
+		case err == voyage.ErrUnknown:
+		case err != nil:
+			return nil, err
+		case !departed(stored, now):
+			continue
+		}
+		if err := r.Store(v); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// departed reports whether every movement of the voyage departed before t.
+func departed(v *voyage.Voyage, t time.Time) bool {
+	for _, m := range v.Schedule.CarrierMovements {
+		if !m.DepartureTime.Before(t) {
+			return false
+		}
+	}
+	return true
+}
+
+type handlingEventRepository struct {
+	db     *bolt.DB
+	logger log.Logger
+}
+
+func (r *handlingEventRepository) Store(e cargo.HandlingEvent) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		r.logger.Log("method", "store", "tracking_id", e.TrackingID, "err", err)
+		return
+	}
+	err = r.db.Update(func(tx *bolt.Tx) error {
+		// Each cargo gets a nested bucket holding its events in the order
+		// they were stored.
+		events, err := tx.Bucket(handlingEventBucket).CreateBucketIfNotExists([]byte(e.TrackingID))
+		if err != nil {
+			return err
+		}
+		seq, err := events.NextSequence()
+		if err != nil {
+			return err
+		}
+		k := make([]byte, 8)
+		binary.BigEndian.PutUint64(k, seq)
+		return events.Put(k, b)
+	})
+	if err != nil {
+		r.logger.Log("method", "store", "tracking_id", e.TrackingID, "err", err)
+	}
+}
+
+func (r *handlingEventRepository) QueryHandlingHistory(id cargo.TrackingID) cargo.HandlingHistory {
+	var h []cargo.HandlingEvent
+	err := r.db.View(func(tx *bolt.Tx) error {
+		events := tx.Bucket(handlingEventBucket).Bucket([]byte(id))
+		if events == nil {
+			return nil
+		}
+		return events.ForEach(func(_, v []byte) error {
+			var e cargo.HandlingEvent
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			h = append(h, e)
+			return nil
+		})
+	})
+	if err != nil {
+		r.logger.Log("method", "query_handling_history", "tracking_id", id, "err", err)
+		return cargo.NewHandlingHistory(nil)
+	}
+	return cargo.NewHandlingHistory(h)
+}
+
+// NewHandlingEventRepository returns a new instance of a bbolt handling event
+// repository. Errors that the repository interface cannot return are logged.
+func NewHandlingEventRepository(db *bolt.DB, logger log.Logger) (cargo.HandlingEventRepository, error) {
+	if err := createBucket(db, handlingEventBucket); err != nil {
+		return nil, err
+	}
+	return &handlingEventRepository{db: db, logger: logger}, nil
+}
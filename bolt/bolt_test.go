@@ -0,0 +1,173 @@
+package bolt
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+func openTestDB(t *testing.T) *bolt.DB {
+	t.Helper()
+	db, err := Open(filepath.Join(t.TempDir(), "shipping.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestCargoRepository(t *testing.T) {
+	r, err := NewCargoRepository(openTestDB(t), log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := cargo.New("ABC123", cargo.RouteSpecification{
+		Origin:      location.SESTO,
+		Destination: location.CNHKG,
+		Deadline:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err := r.Store(c); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := r.Find("ABC123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.RouteSpecification.Destination != location.CNHKG || found.Version != c.Version {
+		t.Errorf("found %+v, want %+v", found, c)
+	}
+
+	stale, _ := r.Find("ABC123")
+	if err := r.Store(found); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Store(stale); !cargo.IsConflict(err) {
+		t.Errorf("storing a stale cargo: got %v, want a conflict", err)
+	}
+
+	if _, err := r.Find("NOPE"); err != cargo.ErrUnknown {
+		t.Errorf("finding an unknown cargo: got %v, want %v", err, cargo.ErrUnknown)
+	}
+	if n := len(r.FindAll()); n != 1 {
+		t.Errorf("found %d cargos, want 1", n)
+	}
+}
+
+func TestLocationRepositorySeedsSampleLocations(t *testing.T) {
+	db := openTestDB(t)
+	r, err := NewLocationRepository(db, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := len(r.FindAll())
+	if n == 0 {
+		t.Fatal("no sample locations")
+	}
+
+	if err := r.Store(&location.Location{UNLcode: "FIKTK", Name: "Kotka"}); err != nil {
+		t.Fatal(err)
+	}
+	r, err = NewLocationRepository(db, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(r.FindAll()); got != n+1 {
+		t.Errorf("found %d locations after reopening, want %d", got, n+1)
+	}
+}
+
+func TestVoyageRepository(t *testing.T) {
+	r, err := NewVoyageRepository(openTestDB(t), log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := r.Find(voyage.V100.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Schedule.CarrierMovements) != len(voyage.V100.Schedule.CarrierMovements) {
+		t.Errorf("found %+v, want %+v", v, voyage.V100)
+	}
+	if _, err := r.Find("NOPE"); err != voyage.ErrUnknown {
+		t.Errorf("finding an unknown voyage: got %v, want %v", err, voyage.ErrUnknown)
+	}
+}
+
+func TestHandlingEventRepository(t *testing.T) {
+	r, err := NewHandlingEventRepository(openTestDB(t), log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t0 := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := cargo.HandlingEvent{TrackingID: "ABC123", Activity: cargo.HandlingActivity{Type: cargo.Unload, Location: location.CNHKG}, Completed: t0.Add(48 * time.Hour), Registered: t0}
+	early := cargo.HandlingEvent{TrackingID: "ABC123", Activity: cargo.HandlingActivity{Type: cargo.Receive, Location: location.SESTO}, Completed: t0, Registered: t0.Add(72 * time.Hour)}
+	other := cargo.HandlingEvent{TrackingID: "FTL456", Activity: cargo.HandlingActivity{Type: cargo.Receive, Location: location.AUMEL}, Completed: t0, Registered: t0}
+	r.Store(late)
+	r.Store(early)
+	r.Store(other)
+
+	h := r.QueryHandlingHistory("ABC123")
+	if len(h.HandlingEvents) != 2 {
+		t.Fatalf("got %d events, want 2", len(h.HandlingEvents))
+	}
+	if h.HandlingEvents[0].Activity.Type != cargo.Receive || h.HandlingEvents[1].Activity.Type != cargo.Unload {
+		t.Errorf("events not ordered by completion time: %+v", h.HandlingEvents)
+	}
+	if n := len(r.QueryHandlingHistory("NOPE").HandlingEvents); n != 0 {
+		t.Errorf("got %d events for an unknown cargo, want 0", n)
+	}
+}
+
+func TestVoyageRepositoryRefreshesDepartedSampleVoyages(t *testing.T) {
+	db := openTestDB(t)
+	r, err := NewVoyageRepository(db, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// As stored by a run long ago.
+	past := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := r.Store(voyage.New(voyage.V100.Number, voyage.Schedule{CarrierMovements: []voyage.CarrierMovement{
+		{DepartureLocation: location.CNHKG, ArrivalLocation: location.JNTKO, DepartureTime: past, ArrivalTime: past.Add(time.Hour)},
+	}})); err != nil {
+		t.Fatal(err)
+	}
+	// Rescheduled, but still to depart.
+	future := time.Now().Add(24 * time.Hour)
+	rescheduled := voyage.New(voyage.V300.Number, voyage.Schedule{CarrierMovements: []voyage.CarrierMovement{
+		{DepartureLocation: location.JNTKO, ArrivalLocation: location.NLRTM, DepartureTime: past, ArrivalTime: past.Add(time.Hour)},
+		{DepartureLocation: location.NLRTM, ArrivalLocation: location.DEHAM, DepartureTime: future, ArrivalTime: future.Add(time.Hour)},
+	}})
+	if err := r.Store(rescheduled); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err = NewVoyageRepository(db, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := r.Find(voyage.V100.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.Schedule.CarrierMovements[0].DepartureTime.Equal(voyage.V100.Schedule.CarrierMovements[0].DepartureTime) {
+		t.Errorf("departed sample voyage not refreshed: %+v", v.Schedule)
+	}
+	v, err = r.Find(voyage.V300.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(v.Schedule.CarrierMovements) != 2 || !v.Schedule.CarrierMovements[1].DepartureTime.Equal(future) {
+		t.Errorf("rescheduled voyage overwritten: %+v", v.Schedule)
+	}
+}
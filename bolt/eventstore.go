@@ -0,0 +1,89 @@
+package bolt
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/go-kit/kit/log"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/eventsource"
+)
+
+var eventBucket = []byte("events")
+
+type eventStore struct {
+	db     *bolt.DB
+	logger log.Logger
+}
+
+func (s *eventStore) Append(id cargo.TrackingID, expected int, events ...cargo.Event) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		stream, err := tx.Bucket(eventBucket).CreateBucketIfNotExists([]byte(id))
+		if err != nil {
+			return err
+		}
+		if current := int(stream.Sequence()); expected != eventsource.AnyVersion && expected != current {
+			return &cargo.ConflictError{TrackingID: id, Expected: expected, Actual: current}
+		}
+		for _, e := range events {
+			seq, err := stream.NextSequence()
+			if err != nil {
+				return err
+			}
+			e.Sequence = int(seq)
+			b, err := json.Marshal(e)
+			if err != nil {
+				return err
+			}
+			k := make([]byte, 8)
+			binary.BigEndian.PutUint64(k, seq)
+			if err := stream.Put(k, b); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *eventStore) Load(id cargo.TrackingID) ([]cargo.Event, error) {
+	var events []cargo.Event
+	err := s.db.View(func(tx *bolt.Tx) error {
+		stream := tx.Bucket(eventBucket).Bucket([]byte(id))
+		if stream == nil {
+			return nil
+		}
+		return stream.ForEach(func(_, v []byte) error {
+			var e cargo.Event
+			if err := json.Unmarshal(v, &e); err != nil {
+				return err
+			}
+			events = append(events, e)
+			return nil
+		})
+	})
+	return events, err
+}
+
+func (s *eventStore) TrackingIDs() []cargo.TrackingID {
+	var ids []cargo.TrackingID
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(eventBucket).ForEach(func(k, _ []byte) error {
+			ids = append(ids, cargo.TrackingID(k))
+			return nil
+		})
+	}); err != nil {
+		s.logger.Log("method", "tracking_ids", "err", err)
+	}
+	return ids
+}
+
+// NewEventStore returns a new instance of a bbolt event store. Errors that
+// the store interface cannot return are logged.
+func NewEventStore(db *bolt.DB, logger log.Logger) (eventsource.Store, error) {
+	if err := createBucket(db, eventBucket); err != nil {
+		return nil, err
+	}
+	return &eventStore{db: db, logger: logger}, nil
+}
@@ -0,0 +1,62 @@
+package bolt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/eventsource"
+	"github.com/Qalifah/shipping/location"
+)
+
+func TestEventStore(t *testing.T) {
+	s, err := NewEventStore(openTestDB(t), log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t0 := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	rs := cargo.RouteSpecification{Origin: location.SESTO, Destination: location.CNHKG, Deadline: t0}
+	handled := cargo.HandlingEvent{ID: "1", TrackingID: "ABC123", Activity: cargo.HandlingActivity{Type: cargo.Receive, Location: location.SESTO}, Completed: t0, Registered: t0}
+
+	if err := s.Append("ABC123", 0,
+		cargo.Event{TrackingID: "ABC123", Type: cargo.Booked, Occurred: t0, RouteSpecification: rs},
+		cargo.Event{TrackingID: "ABC123", Type: cargo.RouteSpecified, Occurred: t0, RouteSpecification: rs},
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Append("ABC123", eventsource.AnyVersion, cargo.Event{TrackingID: "ABC123", Type: cargo.Handled, Occurred: t0, HandlingEvent: handled}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Append("ABC123", 2, cargo.Event{TrackingID: "ABC123", Type: cargo.RouteSpecified}); !cargo.IsConflict(err) {
+		t.Errorf("appending to a stale stream: got %v, want a conflict", err)
+	}
+
+	events, err := s.Load("ABC123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3", len(events))
+	}
+	for i, e := range events {
+		if e.Sequence != i+1 {
+			t.Errorf("event %d has sequence %d", i, e.Sequence)
+		}
+	}
+	if events[0].Type != cargo.Booked || events[0].RouteSpecification.Destination != location.CNHKG || !events[0].RouteSpecification.Deadline.Equal(t0) {
+		t.Errorf("got %+v, want the booking", events[0])
+	}
+	if got := events[2].HandlingEvent; got.ID != handled.ID || got.Activity != handled.Activity || !got.Completed.Equal(handled.Completed) {
+		t.Errorf("got %+v, want %+v", got, handled)
+	}
+
+	if events, err := s.Load("NOPE"); err != nil || len(events) != 0 {
+		t.Errorf("loading an unknown stream: got %d events and %v, want none", len(events), err)
+	}
+	if ids := s.TrackingIDs(); len(ids) != 1 || ids[0] != "ABC123" {
+		t.Errorf("got tracking IDs %v, want [ABC123]", ids)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Qalifah/shipping/cargo"
 	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/routing"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/metrics"
@@ -64,6 +65,8 @@ func makeLoadCargoEndpoint(s Service) endpoint.Endpoint {
 
 type requestRoutesRequest struct {
 	ID cargo.TrackingID
+	Constraints	routing.Constraints
+	Rank	[]routing.Criterion
 }
 
 type requestRoutesResponse struct {
@@ -76,8 +79,8 @@ func(r requestRoutesResponse) error() error { return r.Err }
 func makeRequestRoutesEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(requestRoutesRequest)
-		itin := s.RequestPossibleRoutesForCargo(req.ID)
-		return requestRoutesResponse{Routes: itin, Err: nil}, nil
+		itin, err := s.RequestPossibleRoutesForCargo(req.ID, req.Constraints, req.Rank...)
+		return requestRoutesResponse{Routes: itin, Err: err}, nil
 	}
 }
 
@@ -256,7 +259,7 @@ func NewSet(svc Service, logger log.Logger, duration metrics.Histogram, otTracer
 		AssignRouteEndpoint: assignRouteEndpoint,
 		ChangeDestinationEndpoint: changeDestinationEndpoint,
 		ListCargosEndpoint: listCargosEndpoint,
-		ListLocationsEndpoint: listCargosEndpoint,
+		ListLocationsEndpoint: listLocationsEndpoint,
 	}
 }
 // BookNewCargo implements the service interface so Set can be used as a service
@@ -280,13 +283,13 @@ func(s Set) LoadCargo(id cargo.TrackingID) (Cargo, error) {
 }
 
 // RequestPossibleRoutesForCargo implements the service interface so Set can be used as a service
-func(s Set) RequestPossibleRoutesForCargo(id cargo.TrackingID) []cargo.Itinerary {
-	resp, err := s.RequestRoutesEndpoint(context.Background(), requestRoutesRequest{ID: id})
+func(s Set) RequestPossibleRoutesForCargo(id cargo.TrackingID, constraints routing.Constraints, criteria ...routing.Criterion) ([]cargo.Itinerary, error) {
+	resp, err := s.RequestRoutesEndpoint(context.Background(), requestRoutesRequest{ID: id, Constraints: constraints, Rank: criteria})
 	if err != nil {
-		return []cargo.Itinerary{}
+		return nil, err
 	}
 	response := resp.(requestRoutesResponse)
-	return response.Routes
+	return response.Routes, response.Err
 }
 
 // AssignCargoToRoute implements the service interface so Set can be used as a service
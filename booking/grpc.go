@@ -3,6 +3,7 @@ package booking
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc"
@@ -10,6 +11,7 @@ import (
 	"github.com/Qalifah/shipping/cargo"
 	"github.com/Qalifah/shipping/location"
 	pb "github.com/Qalifah/shipping/pb/bookingpb"
+	"github.com/Qalifah/shipping/routing"
 	"github.com/Qalifah/shipping/voyage"
 
 	"github.com/golang/protobuf/ptypes"
@@ -66,14 +68,14 @@ func NewGRPCServer(endpoints Set, otTracer stdopentracing.Tracer, zipkinTracer *
 		requestRoutes: grpctransport.NewServer(
 			endpoints.RequestRoutesEndpoint,
 			decodeGRPCRoutesForCargoRequest,
-			encodeGRPCBookCargoResponse,
+			encodeGRPCRoutesForCargoResponse,
 			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "requestRoutes", logger)))...,
 		),
 
 		assignRoute: grpctransport.NewServer(
 			endpoints.AssignRouteEndpoint,
-			decodeGRPCBookCargoRequest,
-			encodeGRPCBookCargoResponse,
+			decodeGRPCCargoToRouteRequest,
+			encodeGRPCCargoToRouteResponse,
 			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "assignRoute", logger)))...,
 		),
 
@@ -174,8 +176,8 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 	{
 		bookCargoEndpoint = grpctransport.NewClient(
 			conn,
-			"pb.Booking",
-			"BookCargo",
+			"bookingpb.Booking",
+			"BookNewCargo",
 			encodeGRPCBookCargoRequest,
 			decodeGRPCBookCargoResponse,
 			pb.NewCargoReply{},
@@ -193,7 +195,7 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 	{
 		loadCargoEndpoint = grpctransport.NewClient(
 			conn,
-			"pb.Booking",
+			"bookingpb.Booking",
 			"LoadCargo",
 			encodeGRPCLoadCargoRequest,
 			decodeGRPCLoadCargoResponse,
@@ -212,8 +214,8 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 	{
 		requestRoutesEndpoint = grpctransport.NewClient(
 			conn,
-			"pb.Booking",
-			"RequestRoutes",
+			"bookingpb.Booking",
+			"RequestPossibleRoutesForCargo",
 			encodeGRPCRoutesForCargoRequest,
 			decodeGRPCRoutesForCargoResponse,
 			pb.RoutesForCargoReply{},
@@ -231,8 +233,8 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 	{
 		assignRouteEndpoint = grpctransport.NewClient(
 			conn,
-			"pb.Booking",
-			"AssignRoute",
+			"bookingpb.Booking",
+			"AssignCargoToRoute",
 			encodeGRPCCargoToRouteRequest,
 			decodeGRPCCargoToRouteResponse,
 			pb.CargoToRouteReply{},
@@ -250,7 +252,7 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 	{
 		changeDestinationEndpoint = grpctransport.NewClient(
 			conn,
-			"pb.Booking",
+			"bookingpb.Booking",
 			"ChangeDestination",
 			encodeGRPCChangeDestinationRequest,
 			decodeGRPCChangeDestinationResponse,
@@ -269,7 +271,7 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 	{
 		listCargosEndpoint = grpctransport.NewClient(
 			conn,
-			"pb.Booking",
+			"bookingpb.Booking",
 			"Cargos",
 			encodeGRPCCargosRequest,
 			decodeGRPCCargosResponse,
@@ -288,7 +290,7 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 	{
 		listLocationsEndpoint = grpctransport.NewClient(
 			conn,
-			"pb.Booking",
+			"bookingpb.Booking",
 			"Locations",
 			encodeGRPCLocationsRequest,
 			decodeGRPCLocationsResponse,
@@ -297,7 +299,7 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 		).Endpoint()
 		listLocationsEndpoint = opentracing.TraceClient(otTracer, "Locations")(listLocationsEndpoint)
 		listLocationsEndpoint = limiter(listLocationsEndpoint)
-		listCargosEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+		listLocationsEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
 			Name:    "Locations",
 			Timeout: 30 * time.Second,
 		}))(listLocationsEndpoint)
@@ -333,8 +335,18 @@ func decodeGRPCLoadCargoRequest(_ context.Context, grpcReq interface{}) (interfa
 
 func decodeGRPCRoutesForCargoRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*pb.RoutesForCargoRequest)
+	rank, err := routing.ParseCriteria(strings.Join(req.Rank, ","))
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+	constraints := decodeConstraints(req.Constraints)
+	if constraints.MaxLegs < 0 || constraints.MinConnectionTime < 0 {
+		return nil, ErrInvalidArgument
+	}
 	return requestRoutesRequest{
 		ID: cargo.TrackingID(req.TrackingId),
+		Constraints: constraints,
+		Rank: rank,
 	}, nil
 }
 
@@ -394,6 +406,7 @@ func encodeGRPCRoutesForCargoResponse(_ context.Context, response interface{}) (
 	}
 	return &pb.RoutesForCargoReply{
 		Itineraries: itineraries,
+		Err:         err2str(resp.Err),
 	}, nil
 }
 
@@ -444,7 +457,11 @@ func encodeGRPCLoadCargoRequest(_ context.Context, request interface{}) (interfa
 
 func encodeGRPCRoutesForCargoRequest(_ context.Context, request interface{}) (interface{}, error) {
 	req := request.(requestRoutesRequest)
-	return &pb.RoutesForCargoRequest{TrackingId: string(req.ID)}, nil
+	rank := make([]string, 0, len(req.Rank))
+	for _, c := range req.Rank {
+		rank = append(rank, string(c))
+	}
+	return &pb.RoutesForCargoRequest{TrackingId: string(req.ID), Constraints: encodeConstraints(req.Constraints), Rank: rank}, nil
 }
 
 func encodeGRPCCargoToRouteRequest(_ context.Context, request interface{}) (interface{}, error) {
@@ -491,7 +508,7 @@ func decodeGRPCRoutesForCargoResponse(_ context.Context, grpcReply interface{})
 	}
 	return requestRoutesResponse{
 		Routes: itineraries,
-		Err:    nil,
+		Err:    str2err(reply.Err),
 	}, nil
 }
 
@@ -576,13 +593,16 @@ func decodeLegs(encodedLegs []*pb.Leg) []cargo.Leg {
 func encodeCargo(decodedCargo Cargo) *pb.Cargo {
 	arrivalDeadline, _ := ptypes.TimestampProto(decodedCargo.ArrivalDeadline)
 	encodedCargo := &pb.Cargo{
-		ArrivalDeadline: arrivalDeadline,
-		Destination:     decodedCargo.Destination,
-		Legs:            encodeLegs(decodedCargo.Legs),
-		Misrouted:       decodedCargo.Misrouted,
-		Origin:          decodedCargo.Origin,
-		Routed:          decodedCargo.Routed,
-		TrackingId:      decodedCargo.TrackingID,
+		ArrivalDeadline:  arrivalDeadline,
+		Destination:      decodedCargo.Destination,
+		Distance:         decodedCargo.Distance,
+		Legs:             encodeLegs(decodedCargo.Legs),
+		Misrouted:        decodedCargo.Misrouted,
+		MissedConnection: decodedCargo.MissedConnection,
+		Late:             decodedCargo.Late,
+		Origin:           decodedCargo.Origin,
+		Routed:           decodedCargo.Routed,
+		TrackingId:       decodedCargo.TrackingID,
 	}
 	return encodedCargo
 }
@@ -590,13 +610,16 @@ func encodeCargo(decodedCargo Cargo) *pb.Cargo {
 func decodeCargo(encodedCargo *pb.Cargo) *Cargo {
 	arrivalDeadline, _ := ptypes.Timestamp(encodedCargo.ArrivalDeadline)
 	decodedCargo := &Cargo{
-		ArrivalDeadline: arrivalDeadline,
-		Destination:     encodedCargo.Destination,
-		Legs:            decodeLegs(encodedCargo.Legs),
-		Misrouted:       encodedCargo.Misrouted,
-		Origin:          encodedCargo.Origin,
-		Routed:          encodedCargo.Routed,
-		TrackingID:      encodedCargo.TrackingId,
+		ArrivalDeadline:  arrivalDeadline,
+		Destination:      encodedCargo.Destination,
+		Distance:         encodedCargo.Distance,
+		Legs:             decodeLegs(encodedCargo.Legs),
+		Misrouted:        encodedCargo.Misrouted,
+		MissedConnection: encodedCargo.MissedConnection,
+		Late:             encodedCargo.Late,
+		Origin:           encodedCargo.Origin,
+		Routed:           encodedCargo.Routed,
+		TrackingID:       encodedCargo.TrackingId,
 	}
 	return decodedCargo
 }
@@ -616,3 +639,42 @@ func decodeLocation(encodedLocation *pb.Location) Location {
 	}
 	return decodedLocation
 }
+
+func encodeConstraints(c routing.Constraints) *pb.RouteConstraints {
+	if c.IsZero() {
+		return nil
+	}
+	pc := &pb.RouteConstraints{
+		MaxLegs:              int32(c.MaxLegs),
+		MinConnectionSeconds: int64(c.MinConnectionTime / time.Second),
+	}
+	for _, l := range c.ExcludedLocations {
+		pc.ExcludedLocations = append(pc.ExcludedLocations, string(l))
+	}
+	for _, v := range c.AllowedVoyages {
+		pc.AllowedVoyages = append(pc.AllowedVoyages, string(v))
+	}
+	for _, v := range c.ForbiddenVoyages {
+		pc.ForbiddenVoyages = append(pc.ForbiddenVoyages, string(v))
+	}
+	return pc
+}
+
+func decodeConstraints(pc *pb.RouteConstraints) routing.Constraints {
+	var c routing.Constraints
+	if pc == nil {
+		return c
+	}
+	c.MaxLegs = int(pc.MaxLegs)
+	c.MinConnectionTime = time.Duration(pc.MinConnectionSeconds) * time.Second
+	for _, l := range pc.ExcludedLocations {
+		c.ExcludedLocations = append(c.ExcludedLocations, location.UNLcode(l))
+	}
+	for _, v := range pc.AllowedVoyages {
+		c.AllowedVoyages = append(c.AllowedVoyages, voyage.Number(v))
+	}
+	for _, v := range pc.ForbiddenVoyages {
+		c.ForbiddenVoyages = append(c.ForbiddenVoyages, voyage.Number(v))
+	}
+	return c
+}
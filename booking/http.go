@@ -15,6 +15,7 @@ import (
 
 	"github.com/Qalifah/shipping/location"
 	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/routing"
 )
 
 // MakeHandler returns a handler for the booking service.
@@ -116,7 +117,16 @@ func decodeRequestRoutesRequest(_ context.Context, r *http.Request) (interface{}
 	if !ok {
 		return nil, errBadRoute
 	}
-	return requestRoutesRequest{ID: cargo.TrackingID(id)}, nil
+	q := r.URL.Query()
+	constraints, err := routing.ParseConstraints(q)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+	rank, err := routing.ParseCriteria(q.Get("rank"))
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return requestRoutesRequest{ID: cargo.TrackingID(id), Constraints: constraints, Rank: rank}, nil
 }
 
 func decodeAssignToRouteRequest(_ context.Context, r *http.Request) (interface{}, error) {
@@ -182,11 +192,13 @@ type errorer interface {
 // encode errors from business-logic
 func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
-	switch err {
-	case cargo.ErrUnknown:
+	switch {
+	case err == cargo.ErrUnknown:
 		w.WriteHeader(http.StatusNotFound)
-	case ErrInvalidArgument:
+	case err == ErrInvalidArgument:
 		w.WriteHeader(http.StatusBadRequest)
+	case routing.IsUnavailable(err):
+		w.WriteHeader(http.StatusServiceUnavailable)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 	}
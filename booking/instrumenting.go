@@ -6,6 +6,7 @@ import(
 	"github.com/go-kit/kit/metrics"
 
 	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/routing"
 	"github.com/Qalifah/shipping/location"
 )
 
@@ -41,13 +42,13 @@ func (s *instrumentingService) LoadCargo(id cargo.TrackingID) (c Cargo, err erro
 	return s.Service.LoadCargo(id)
 }
 
-func (s *instrumentingService) RequestPossibleRoutesForCargo(id cargo.TrackingID) []cargo.Itinerary {
+func (s *instrumentingService) RequestPossibleRoutesForCargo(id cargo.TrackingID, constraints routing.Constraints, criteria ...routing.Criterion) ([]cargo.Itinerary, error) {
 	defer func(begin time.Time) {
 		s.requestCount.With("method", "request_routes").Add(1)
 		s.requestLatency.With("method", "request_routes").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return s.Service.RequestPossibleRoutesForCargo(id)
+	return s.Service.RequestPossibleRoutesForCargo(id, constraints, criteria...)
 }
 
 func (s *instrumentingService) AssignCargoToRoute(id cargo.TrackingID, itinerary cargo.Itinerary) (err error) {
@@ -1,12 +1,14 @@
 package booking
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/go-kit/kit/log"
 
 	"github.com/Qalifah/shipping/location"
 	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/routing"
 )
 
 type loggingService struct {
@@ -45,15 +47,18 @@ func(s *loggingService) LoadCargo(id cargo.TrackingID) (c Cargo, err error) {
 	return s.Service.LoadCargo(id)
 }
 
-func(s *loggingService) RequestPossibleRoutesForCargo(id cargo.TrackingID) []cargo.Itinerary {
+func(s *loggingService) RequestPossibleRoutesForCargo(id cargo.TrackingID, constraints routing.Constraints, criteria ...routing.Criterion) (itineraries []cargo.Itinerary, err error) {
 	defer func(begin time.Time) {
 		s.logger.Log(
 			"method", "request_routes",
 			"tracking_id", id,
+			"constraints", constraints,
+			"rank", fmt.Sprint(criteria),
 			"took", time.Since(begin),
+			"err", err,
 		)
 	}(time.Now())
-	return s.Service.RequestPossibleRoutesForCargo(id)
+	return s.Service.RequestPossibleRoutesForCargo(id, constraints, criteria...)
 }
 
 func (s *loggingService) AssignCargoToRoute(id cargo.TrackingID, itinerary cargo.Itinerary) (err error) {
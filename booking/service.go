@@ -22,8 +22,12 @@ type Service interface {
 	LoadCargo(id cargo.TrackingID) (Cargo, error)
 
 	// RequestPossibleRoutesForCargo requests a list of itineraries describing
-	// possible routes for this cargo.
-	RequestPossibleRoutesForCargo(id cargo.TrackingID) []cargo.Itinerary
+	// possible routes for this cargo within the given constraints.
+	// Itineraries arriving after the deadline are left out, and the rest are
+	// ranked by the given criteria, or by routing.DefaultCriteria if there are
+	// none. It fails with a *routing.UnavailableError if the routing service
+	// cannot be reached.
+	RequestPossibleRoutesForCargo(id cargo.TrackingID, constraints routing.Constraints, criteria ...routing.Criterion) ([]cargo.Itinerary, error)
 
 	// AssignCargoToRoute assigns a cargo to the route specified by the
 	// itinerary.
@@ -50,12 +54,11 @@ func(s *service) AssignCargoToRoute(id cargo.TrackingID, itinerary cargo.Itinera
 	if id == "" || len(itinerary.Legs) == 0 {
 		return ErrInvalidArgument
 	}
-	c, err := s.cargos.Find(id)
-	if err != nil {
-		return err
-	}
-	c.AssignToRoute(itinerary)
-	return s.cargos.Store(c)
+	_, err := cargo.Update(s.cargos, id, func(c *cargo.Cargo) error {
+		c.AssignToRoute(itinerary)
+		return nil
+	})
+	return err
 }
 
 func(s *service) BookNewCargo(origin location.UNLcode, destination location.UNLcode, deadline time.Time)(cargo.TrackingID, error) {
@@ -84,49 +87,53 @@ func(s *service) LoadCargo(id cargo.TrackingID) (Cargo, error) {
 	if err != nil {
 		return Cargo{}, err
 	}
-	return assemble(c, s.handlingEvents), nil
+	return assemble(c, s.handlingEvents, s.locations), nil
 }
 
 func(s *service) ChangeDestination(id cargo.TrackingID, destination location.UNLcode) error {
 	if id == "" || destination == "" {
 		return ErrInvalidArgument
 	}
-	c, err := s.cargos.Find(id)
-	if err != nil {
-		return err
-	}
 	l, err := s.locations.Find(destination)
 	if err != nil {
 		return err
 	}
-	c.SpecifyNewRoute(cargo.RouteSpecification{
-		Origin: c.Origin,
-		Destination: l.UNLcode,
-		Deadline: c.RouteSpecification.Deadline,
+	_, err = cargo.Update(s.cargos, id, func(c *cargo.Cargo) error {
+		c.SpecifyNewRoute(cargo.RouteSpecification{
+			Origin: c.Origin,
+			Destination: l.UNLcode,
+			Deadline: c.RouteSpecification.Deadline,
+		})
+		return nil
 	})
-	if err := s.cargos.Store(c); err != nil {
-		return err
-	}
-	return nil
+	return err
 }
 
-func (s *service) RequestPossibleRoutesForCargo(id cargo.TrackingID) []cargo.Itinerary {
+func (s *service) RequestPossibleRoutesForCargo(id cargo.TrackingID, constraints routing.Constraints, criteria ...routing.Criterion) ([]cargo.Itinerary, error) {
 	if id == "" {
-		return nil
+		return nil, ErrInvalidArgument
 	}
 
 	c, err := s.cargos.Find(id)
 	if err != nil {
-		return []cargo.Itinerary{}
+		return nil, err
 	}
 
-	return s.routingService.FetchRoutesForSpecification(c.RouteSpecification)
+	routes, err := s.routingService.FetchRoutesForSpecification(c.RouteSpecification, constraints)
+	if err != nil {
+		return nil, err
+	}
+
+	itineraries := routing.MeetingDeadline(c.RouteSpecification, routes)
+	routing.Rank(itineraries, criteria...)
+
+	return itineraries, nil
 }
 
 func (s *service) Cargos() []Cargo {
 	var result []Cargo
 	for _, c := range s.cargos.FindAll() {
-		result = append(result, assemble(c, s.handlingEvents))
+		result = append(result, assemble(c, s.handlingEvents, s.locations))
 	}
 	return result
 }
@@ -163,19 +170,29 @@ type Location struct {
 type Cargo struct {
 	ArrivalDeadline		time.Time	`json:"arrival_deadline"`
 	Destination			string		`json:"destination"`
+	Distance			float64		`json:"distance,omitempty"`
 	Legs				[]cargo.Leg		`json:"legs,omitempty"`
 	Misrouted			bool			`json:"misrouted"`
+	MissedConnection	bool			`json:"missed_connection"`
+	Late				bool			`json:"late"`
 	Origin				string			`json:"origin"`
 	Routed				bool			`json:"routed"`
 	TrackingID			string			`json:"tracking_id"`
 }
 
-func assemble(c *cargo.Cargo, events cargo.HandlingEventRepository) Cargo {
+func assemble(c *cargo.Cargo, events cargo.HandlingEventRepository, locations location.Repository) Cargo {
+	// The distance is left out if a location of the itinerary has no
+	// coordinates.
+	distance, _ := c.Itinerary.Distance(locations)
+
 	return Cargo{
 		TrackingID: string(c.TrackingID),
 		Origin: string(c.Origin),
 		Destination: string(c.RouteSpecification.Destination),
+		Distance: distance,
 		Misrouted: c.Delivery.RoutingStatus == cargo.MisRouted,
+		MissedConnection: c.Delivery.MissesConnection(),
+		Late: c.Delivery.IsLate(),
 		Routed: !c.Itinerary.IsEmpty(),
 		ArrivalDeadline: c.RouteSpecification.Deadline,
 		Legs: c.Itinerary.Legs,
@@ -2,6 +2,7 @@ package cargo
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/Qalifah/shipping/location"
@@ -18,18 +19,28 @@ type Cargo struct {
 	RouteSpecification	RouteSpecification
 	Itinerary 		Itinerary
 	Delivery		Delivery
+
+	// Version is incremented by the repository every time the cargo is
+	// stored, and is used to detect concurrent modifications.
+	Version		int
+
+	// changes holds the domain events recorded since the cargo was last
+	// stored.
+	changes		[]Event
 }
 
 // SpecifyNewRoute specifies a new route for this cargo
 func(c *Cargo) SpecifyNewRoute(rs RouteSpecification) {
 	c.RouteSpecification = rs
 	c.Delivery = c.Delivery.UpdateOnRouting(c.RouteSpecification, c.Itinerary)
+	c.record(Event{Type: RouteSpecified, RouteSpecification: rs})
 }
 
 // AssignToRoute attachs a new itinerary to the cargo
 func(c *Cargo) AssignToRoute(itinerary Itinerary) {
 	c.Itinerary = itinerary
 	c.Delivery = c.Delivery.UpdateOnRouting(c.RouteSpecification, c.Itinerary)
+	c.record(Event{Type: AssignedToRoute, Itinerary: itinerary})
 }
 
 // DeriveDeliveryProgress updates all aspects of the cargo aggregate status
@@ -43,15 +54,29 @@ func New(id TrackingID, rs RouteSpecification) *Cargo {
 	itinerary := Itinerary{}
 	history := HandlingHistory{make([]HandlingEvent, 0)}
 
-	return &Cargo{
+	c := &Cargo{
 		TrackingID:         id,
 		Origin:             rs.Origin,
 		RouteSpecification: rs,
 		Delivery:           DeriveDeliveryFrom(rs, itinerary, history),
 	}
+	c.record(Event{Type: Booked, RouteSpecification: rs})
+
+	return c
+}
+
+// Clone returns a deep copy of the cargo
+func(c *Cargo) Clone() *Cargo {
+	clone := *c
+	clone.Itinerary = c.Itinerary.clone()
+	clone.Delivery.Itinerary = c.Delivery.Itinerary.clone()
+	clone.changes = append([]Event(nil), c.changes...)
+	return &clone
 }
 
-// Repository provides access to cargo store
+// Repository provides access to cargo store. Implementations hand out copies
+// of the stored cargos, and Store fails with a *ConflictError if the cargo was
+// stored by someone else since it was found.
 type Repository interface {
 	Store(cargo *Cargo) error
 	Find(id TrackingID) (*Cargo, error)
@@ -61,6 +86,51 @@ type Repository interface {
 // ErrUnknown is used when a cargo can't be found
 var ErrUnknown = errors.New("unknown cargo")
 
+// ConflictError is returned when storing a cargo that has been modified since
+// it was found.
+type ConflictError struct {
+	TrackingID TrackingID
+	Expected   int
+	Actual     int
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("cargo %s was modified concurrently: expected version %d, found %d", e.TrackingID, e.Expected, e.Actual)
+}
+
+// IsConflict reports whether err is a *ConflictError
+func IsConflict(err error) bool {
+	var e *ConflictError
+	return errors.As(err, &e)
+}
+
+// maxUpdateAttempts is the number of times Update tries to apply a change
+// before giving up on conflicts.
+const maxUpdateAttempts = 5
+
+// Update finds the cargo, applies fn to it and stores it, starting over when
+// the store fails because of a concurrent modification. It returns the
+// cargo as stored.
+func Update(r Repository, id TrackingID, fn func(*Cargo) error) (*Cargo, error) {
+	var err error
+	for i := 0; i < maxUpdateAttempts; i++ {
+		var c *Cargo
+		if c, err = r.Find(id); err != nil {
+			return nil, err
+		}
+		if err = fn(c); err != nil {
+			return nil, err
+		}
+		if err = r.Store(c); err == nil {
+			return c, nil
+		}
+		if !IsConflict(err) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
 // NextTrackingID generates a new tracking ID.
 func NextTrackingID() TrackingID {
 	return TrackingID(strings.Split(strings.ToUpper(uuid.New()), "-")[0])
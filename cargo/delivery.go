@@ -35,6 +35,17 @@ func(d Delivery) IsOnTrack() bool {
 	return d.RoutingStatus == Routed && !d.IsMisdirected
 }
 
+// MissesConnection checks if the itinerary can no longer be followed because
+// a leg is loaded before the previous one is unloaded
+func(d Delivery) MissesConnection() bool {
+	return d.Itinerary.MissesConnection()
+}
+
+// IsLate checks if the cargo is expected to arrive after the deadline
+func(d Delivery) IsLate() bool {
+	return !d.ETA.IsZero() && !d.RouteSpecification.Deadline.IsZero() && d.ETA.After(d.RouteSpecification.Deadline)
+}
+
 // DeriveDeliveryFrom creates a new delivery snapshot based on the complete
 // handling history of a cargo, as well as its route specification and
 // itinerary.
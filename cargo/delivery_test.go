@@ -0,0 +1,145 @@
+package cargo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+var t0 = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func at(hours int) time.Time {
+	return t0.Add(time.Duration(hours) * time.Hour)
+}
+
+func event(typ HandlingEventType, loc location.UNLcode, v voyage.Number, completed, registered time.Time) HandlingEvent {
+	return HandlingEvent{
+		TrackingID: "ABC123",
+		Activity:   HandlingActivity{Type: typ, Location: loc, VoyageNumber: v},
+		Completed:  completed,
+		Registered: registered,
+	}
+}
+
+func TestMostRecentlyCompletedEventIgnoresLateOlderEvent(t *testing.T) {
+	var (
+		receive = event(Receive, location.SESTO, "", at(0), at(0))
+		load    = event(Load, location.SESTO, "V100", at(10), at(10))
+		unload  = event(Unload, location.USNYC, "V100", at(20), at(20))
+		// Completed before the unload, but only reported after it.
+		customs = event(Customs, location.SESTO, "", at(5), at(30))
+	)
+
+	h := NewHandlingHistory([]HandlingEvent{receive, load, unload, customs})
+
+	got, err := h.MostRecentlyCompletedEvent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != unload {
+		t.Errorf("most recent event: got %+v, want %+v", got.Activity, unload.Activity)
+	}
+
+	want := []HandlingEvent{receive, customs, load, unload}
+	for i, e := range h.HandlingEvents {
+		if e != want[i] {
+			t.Errorf("event %d: got %+v, want %+v", i, e.Activity, want[i].Activity)
+		}
+	}
+}
+
+func TestMostRecentlyCompletedEventTieBreak(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		first, last HandlingEvent
+	}{
+		{
+			name:  "registered later",
+			first: event(Unload, location.USNYC, "V100", at(20), at(21)),
+			last:  event(Receive, location.USNYC, "", at(20), at(22)),
+		},
+		{
+			name:  "registered together",
+			first: event(Load, location.USNYC, "V100", at(20), at(20)),
+			last:  event(Unload, location.USNYC, "V100", at(20), at(20)),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			// The outcome must not depend on the order events were stored in.
+			for _, events := range [][]HandlingEvent{
+				{tt.first, tt.last},
+				{tt.last, tt.first},
+			} {
+				h := NewHandlingHistory(events)
+
+				got, err := h.MostRecentlyCompletedEvent()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != tt.last {
+					t.Errorf("most recent event: got %+v, want %+v", got.Activity, tt.last.Activity)
+				}
+				if h.HandlingEvents[0] != tt.first || h.HandlingEvents[1] != tt.last {
+					t.Errorf("events not in a deterministic order: %+v", h.HandlingEvents)
+				}
+			}
+		})
+	}
+}
+
+func TestDeriveDeliveryFromOutOfOrderHistory(t *testing.T) {
+	var (
+		rs = RouteSpecification{
+			Origin:      location.SESTO,
+			Destination: location.CNHKG,
+			Deadline:    at(100),
+		}
+		itinerary = Itinerary{Legs: []Leg{
+			NewLeg("V100", location.SESTO, location.USNYC, at(10), at(20)),
+			NewLeg("V300", location.USNYC, location.CNHKG, at(30), at(40)),
+		}}
+
+		receive = event(Receive, location.SESTO, "", at(0), at(0))
+		load    = event(Load, location.SESTO, "V100", at(10), at(10))
+		unload  = event(Unload, location.USNYC, "V100", at(20), at(20))
+	)
+
+	d := DeriveDeliveryFrom(rs, itinerary, NewHandlingHistory([]HandlingEvent{receive, unload}))
+	if d.LastEvent != unload {
+		t.Fatalf("last event: got %+v, want %+v", d.LastEvent.Activity, unload.Activity)
+	}
+
+	// The load is reported after the unload that followed it.
+	late := load
+	late.Registered = at(25)
+	d = DeriveDeliveryFrom(rs, itinerary, NewHandlingHistory([]HandlingEvent{receive, unload, late}))
+
+	if d.LastEvent != unload {
+		t.Errorf("last event: got %+v, want %+v", d.LastEvent.Activity, unload.Activity)
+	}
+	if d.TransportStatus != InPort {
+		t.Errorf("transport status: got %v, want %v", d.TransportStatus, InPort)
+	}
+	if d.LastKnownLocation != location.USNYC {
+		t.Errorf("last known location: got %v, want %v", d.LastKnownLocation, location.USNYC)
+	}
+	if d.CurrentVoyage != "" {
+		t.Errorf("current voyage: got %v, want none", d.CurrentVoyage)
+	}
+	if d.IsMisdirected {
+		t.Error("delivery is misdirected")
+	}
+	if want := (HandlingActivity{Type: Load, Location: location.USNYC, VoyageNumber: "V300"}); d.NextExpectedActivity != want {
+		t.Errorf("next expected activity: got %+v, want %+v", d.NextExpectedActivity, want)
+	}
+	if !d.ETA.Equal(at(40)) {
+		t.Errorf("ETA: got %v, want %v", d.ETA, at(40))
+	}
+
+	inOrder := DeriveDeliveryFrom(rs, itinerary, NewHandlingHistory([]HandlingEvent{receive, load, unload}))
+	if inOrder.LastEvent.Activity != d.LastEvent.Activity || inOrder.TransportStatus != d.TransportStatus || inOrder.NextExpectedActivity != d.NextExpectedActivity {
+		t.Errorf("got %+v, want the same delivery as in order %+v", d, inOrder)
+	}
+}
@@ -0,0 +1,96 @@
+package cargo
+
+import (
+	"errors"
+	"time"
+)
+
+// EventType describes the kind of a cargo domain event
+type EventType int
+
+// valid domain event types
+const (
+	Booked EventType = iota + 1
+	RouteSpecified
+	AssignedToRoute
+	Handled
+)
+
+func (t EventType) String() string {
+	switch t {
+	case Booked:
+		return "Booked"
+	case RouteSpecified:
+		return "Route Specified"
+	case AssignedToRoute:
+		return "Assigned To Route"
+	case Handled:
+		return "Handled"
+	}
+	return ""
+}
+
+// Event is a domain event recorded against a cargo. Only the fields relevant
+// to its type are set: the route specification for Booked and
+// RouteSpecified, the itinerary for AssignedToRoute and the handling event
+// for Handled.
+type Event struct {
+	TrackingID         TrackingID
+	Sequence           int
+	Type               EventType
+	Occurred           time.Time
+	RouteSpecification RouteSpecification
+	Itinerary          Itinerary
+	HandlingEvent      HandlingEvent
+}
+
+// ErrEmptyStream is used when a cargo is replayed from no events
+var ErrEmptyStream = errors.New("empty event stream")
+
+func (c *Cargo) record(e Event) {
+	e.TrackingID = c.TrackingID
+	e.Occurred = time.Now()
+	c.changes = append(c.changes, e)
+}
+
+// Changes returns the domain events recorded since the cargo was created, or
+// since ClearChanges was last called.
+func (c *Cargo) Changes() []Event {
+	return c.changes
+}
+
+// ClearChanges discards the recorded domain events, typically once they have
+// been persisted.
+func (c *Cargo) ClearChanges() {
+	c.changes = nil
+}
+
+// Replay rebuilds a cargo from its complete stream of domain events. The
+// delivery is derived from scratch, from the final route specification,
+// itinerary and handling history.
+func Replay(events []Event) (*Cargo, error) {
+	if len(events) == 0 || events[0].Type != Booked {
+		return nil, ErrEmptyStream
+	}
+
+	var (
+		c       = &Cargo{TrackingID: events[0].TrackingID, Origin: events[0].RouteSpecification.Origin}
+		handled = make([]HandlingEvent, 0)
+	)
+
+	for _, e := range events {
+		switch e.Type {
+		case Booked, RouteSpecified:
+			c.RouteSpecification = e.RouteSpecification
+		case AssignedToRoute:
+			c.Itinerary = e.Itinerary
+		case Handled:
+			handled = append(handled, e.HandlingEvent)
+		}
+	}
+
+	c.Delivery = DeriveDeliveryFrom(c.RouteSpecification, c.Itinerary, NewHandlingHistory(handled))
+	c.Version = events[len(events)-1].Sequence
+
+	return c, nil
+}
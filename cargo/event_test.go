@@ -0,0 +1,74 @@
+package cargo
+
+import (
+	"testing"
+
+	"github.com/Qalifah/shipping/location"
+)
+
+func TestReplay(t *testing.T) {
+	var (
+		booked    = RouteSpecification{Origin: location.SESTO, Destination: location.USNYC, Deadline: at(100)}
+		rs        = RouteSpecification{Origin: location.SESTO, Destination: location.CNHKG, Deadline: at(200)}
+		itinerary = Itinerary{Legs: []Leg{
+			NewLeg("V100", location.SESTO, location.USNYC, at(10), at(20)),
+			NewLeg("V300", location.USNYC, location.CNHKG, at(30), at(40)),
+		}}
+		load    = event(Load, location.SESTO, "V100", at(10), at(11))
+		receive = event(Receive, location.SESTO, "", at(0), at(12))
+	)
+
+	c, err := Replay([]Event{
+		{TrackingID: "ABC123", Sequence: 1, Type: Booked, RouteSpecification: booked},
+		{TrackingID: "ABC123", Sequence: 2, Type: RouteSpecified, RouteSpecification: rs},
+		{TrackingID: "ABC123", Sequence: 3, Type: AssignedToRoute, Itinerary: itinerary},
+		{TrackingID: "ABC123", Sequence: 4, Type: Handled, HandlingEvent: load},
+		{TrackingID: "ABC123", Sequence: 5, Type: Handled, HandlingEvent: receive},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.TrackingID != "ABC123" || c.Origin != location.SESTO {
+		t.Errorf("got cargo %v from %v, want ABC123 from %v", c.TrackingID, c.Origin, location.SESTO)
+	}
+	if c.RouteSpecification != rs {
+		t.Errorf("route specification: got %+v, want the last specified %+v", c.RouteSpecification, rs)
+	}
+	if len(c.Itinerary.Legs) != 2 {
+		t.Errorf("itinerary: got %+v, want %+v", c.Itinerary, itinerary)
+	}
+	if c.Version != 5 {
+		t.Errorf("version: got %d, want 5", c.Version)
+	}
+
+	// The delivery is derived from the most recently completed event, not
+	// the last one in the stream.
+	if c.Delivery.LastEvent != load {
+		t.Errorf("last event: got %+v, want %+v", c.Delivery.LastEvent.Activity, load.Activity)
+	}
+	if c.Delivery.TransportStatus != OnboardCarrier || c.Delivery.RoutingStatus != Routed {
+		t.Errorf("delivery: got %v and %v, want %v and %v", c.Delivery.TransportStatus, c.Delivery.RoutingStatus, OnboardCarrier, Routed)
+	}
+	if len(c.Changes()) != 0 {
+		t.Errorf("replayed cargo has %d changes, want none", len(c.Changes()))
+	}
+}
+
+func TestReplayEmptyStream(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		events []Event
+	}{
+		{name: "no events"},
+		{name: "not booked first", events: []Event{
+			{TrackingID: "ABC123", Sequence: 1, Type: Handled, HandlingEvent: event(Receive, location.SESTO, "", at(0), at(0))},
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Replay(tt.events); err != ErrEmptyStream {
+				t.Errorf("got %v, want %v", err, ErrEmptyStream)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package cargo
 
 import (
 	"errors"
+	"sort"
 	"time"
 
 	"github.com/Qalifah/shipping/location"
@@ -23,8 +24,11 @@ type HandlingActivity struct {
 // HandlingEvent is used to register the event when, for instance, a cargo is
 // unloaded from a carrier at a some location at a given time.
 type HandlingEvent struct {
+	ID	string
 	TrackingID TrackingID
 	Activity HandlingActivity
+	Completed	time.Time
+	Registered	time.Time
 }
 
 // valid handling event types
@@ -55,17 +59,61 @@ func (t HandlingEventType) String() string {
 	return ""
 }
 
-// HandlingHistory is the handling history of a cargo
+// SameAs reports whether e and other describe the same handling of the same
+// cargo, regardless of their IDs or when they were registered.
+func(e HandlingEvent) SameAs(other HandlingEvent) bool {
+	return e.TrackingID == other.TrackingID &&
+		e.Activity == other.Activity &&
+		e.Completed.Equal(other.Completed)
+}
+
+// CompletedBefore reports whether e was completed before other. Events
+// completed at the same time are ordered by registration time, and then by
+// type, location and voyage, so that the order is always deterministic.
+func(e HandlingEvent) CompletedBefore(other HandlingEvent) bool {
+	switch {
+	case !e.Completed.Equal(other.Completed):
+		return e.Completed.Before(other.Completed)
+	case !e.Registered.Equal(other.Registered):
+		return e.Registered.Before(other.Registered)
+	case e.Activity.Type != other.Activity.Type:
+		return e.Activity.Type < other.Activity.Type
+	case e.Activity.Location != other.Activity.Location:
+		return e.Activity.Location < other.Activity.Location
+	}
+	return e.Activity.VoyageNumber < other.Activity.VoyageNumber
+}
+
+// HandlingHistory is the handling history of a cargo, ordered by completion
+// time.
 type HandlingHistory struct {
 	HandlingEvents []HandlingEvent
 }
 
-// MostRecentlyCompletedEvent returns the most recently completed handling event
+// NewHandlingHistory creates a handling history from events in any order,
+// e.g. the order they were registered in.
+func NewHandlingHistory(events []HandlingEvent) HandlingHistory {
+	sorted := make([]HandlingEvent, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CompletedBefore(sorted[j])
+	})
+	return HandlingHistory{HandlingEvents: sorted}
+}
+
+// MostRecentlyCompletedEvent returns the most recently completed handling
+// event, regardless of when it was registered.
 func(h HandlingHistory) MostRecentlyCompletedEvent() (HandlingEvent, error) {
 	if len(h.HandlingEvents) == 0 {
 		return HandlingEvent{}, errors.New("delivery history is empty")
 	}
-	return h.HandlingEvents[len(h.HandlingEvents)-1], nil
+	last := h.HandlingEvents[0]
+	for _, e := range h.HandlingEvents[1:] {
+		if last.CompletedBefore(e) {
+			last = e
+		}
+	}
+	return last, nil
 }
 
 // HandlingEventRepository provides access to the handling event store
@@ -82,7 +130,7 @@ type HandlingEventFactory struct {
 }
 
 // CreateHandlingEvent creates a validated handling event
-func(f *HandlingEventFactory) CreateHandlingEvent(registered time.Time, completed time.Time, id TrackingID, voyageNumber voyage.Number, unlCode location.UNLcode, eventType HandlingEventType) (HandlingEvent, error) {
+func(f *HandlingEventFactory) CreateHandlingEvent(registered time.Time, completed time.Time, id TrackingID, voyageNumber voyage.Number, unlCode location.UNLcode, eventType HandlingEventType, eventID string) (HandlingEvent, error) {
 	if _, err := f.CargoRepository.Find(id); err != nil {
 		return HandlingEvent{}, err
 	}
@@ -98,11 +146,14 @@ func(f *HandlingEventFactory) CreateHandlingEvent(registered time.Time, complete
 	}
 
 	return HandlingEvent{
+		ID: eventID,
 		TrackingID: id,
 		Activity: HandlingActivity{
 			Type: eventType,
 			Location: unlCode,
 			VoyageNumber: voyageNumber,
 		},
+		Completed: completed,
+		Registered: registered,
 	}, nil
 }
\ No newline at end of file
@@ -32,6 +32,15 @@ type Itinerary struct {
 	Legs []Leg `json:"legs"`
 }
 
+func (i Itinerary) clone() Itinerary {
+	if i.Legs == nil {
+		return i
+	}
+	legs := make([]Leg, len(i.Legs))
+	copy(legs, i.Legs)
+	return Itinerary{Legs: legs}
+}
+
 // IsEmpty checks if the itinerary contains at least one leg
 func (i Itinerary) IsEmpty() bool {
 	return i.Legs == nil || len(i.Legs) == 0
@@ -45,6 +54,14 @@ func (i Itinerary) InitialDepartureLocation() location.UNLcode {
 	return i.Legs[0].LoadLocation
 }
 
+// InitialDepartureTime returns the time the itinerary starts
+func (i Itinerary) InitialDepartureTime() time.Time {
+	if i.IsEmpty() {
+		return time.Time{}
+	}
+	return i.Legs[0].LoadTime
+}
+
 // FinalArrivalLocation returns the end of the itinerary
 func (i Itinerary) FinalArrivalLocation() location.UNLcode {
 	return i.Legs[len(i.Legs)-1].UnLoadLocation
@@ -82,4 +99,57 @@ func (i Itinerary) IsExpected(event HandlingEvent) bool {
 		return i.FinalArrivalLocation() == event.Activity.Location
 	}
 	return true
-}
\ No newline at end of file
+}
+// UsesVoyage checks if any leg of the itinerary is on the voyage
+func (i Itinerary) UsesVoyage(v voyage.Number) bool {
+	for _, l := range i.Legs {
+		if l.VoyageNumber == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Delay returns a copy of the itinerary in which the legs on the voyage are
+// shifted by d, from the time the delay takes effect: legs loading at or
+// after it are loaded later, and legs unloading after it are unloaded later.
+func (i Itinerary) Delay(v voyage.Number, from time.Time, d time.Duration) Itinerary {
+	delayed := i.clone()
+	for j, l := range delayed.Legs {
+		if l.VoyageNumber != v {
+			continue
+		}
+		if !l.LoadTime.Before(from) {
+			delayed.Legs[j].LoadTime = l.LoadTime.Add(d)
+		}
+		if l.UnLoadTime.After(from) {
+			delayed.Legs[j].UnLoadTime = l.UnLoadTime.Add(d)
+		}
+	}
+	return delayed
+}
+
+// MissesConnection checks if a leg of the itinerary is loaded before the
+// previous leg is unloaded
+func (i Itinerary) MissesConnection() bool {
+	for j := 1; j < len(i.Legs); j++ {
+		if i.Legs[j].LoadTime.Before(i.Legs[j-1].UnLoadTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// Distance returns the total great-circle distance of the legs of the
+// itinerary in kilometres
+func (i Itinerary) Distance(locations location.Repository) (float64, error) {
+	var total float64
+	for _, l := range i.Legs {
+		d, err := location.Distance(locations, l.LoadLocation, l.UnLoadLocation)
+		if err != nil {
+			return 0, err
+		}
+		total += d
+	}
+	return total, nil
+}
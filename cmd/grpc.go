@@ -0,0 +1,64 @@
+package main
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/Qalifah/shipping/pb/bookingpb"
+	"github.com/Qalifah/shipping/pb/handlingpb"
+	"github.com/Qalifah/shipping/pb/locatingpb"
+	"github.com/Qalifah/shipping/pb/schedulingpb"
+	"github.com/Qalifah/shipping/pb/trackingpb"
+	"github.com/Qalifah/shipping/booking"
+	"github.com/Qalifah/shipping/handling"
+	"github.com/Qalifah/shipping/locating"
+	"github.com/Qalifah/shipping/scheduling"
+	"github.com/Qalifah/shipping/tracking"
+
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+
+	"github.com/go-kit/kit/log"
+)
+
+// gRPCServers provides access to the grpc servers in our application
+type gRPCServers struct {
+	bookingpb.BookingServer
+	handlingpb.HandlingServer
+	locatingpb.LocatingServer
+	schedulingpb.SchedulingServer
+	trackingpb.TrackingServer
+}
+
+// NewgRPCServers creates a new instance of gRPCServers
+func NewgRPCServers(bookingSet booking.Set, handlingSet handling.Set, locatingSet locating.Set, schedulingSet scheduling.Set, trackingSet tracking.Set, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer, logger log.Logger) gRPCServers {
+	return gRPCServers{
+		booking.NewGRPCServer(bookingSet, otTracer, zipkinTracer, logger),
+		handling.NewGRPCServer(handlingSet, otTracer, zipkinTracer, logger),
+		locating.NewGRPCServer(locatingSet, otTracer, zipkinTracer, logger),
+		scheduling.NewGRPCServer(schedulingSet, otTracer, zipkinTracer, logger),
+		tracking.NewGRPCServer(trackingSet, otTracer, zipkinTracer, logger),
+	}
+}
+
+// register registers the servers on s, together with server reflection and
+// health checking. Each service, and the server as a whole, is reported as
+// serving until the returned health server is shut down.
+func (g gRPCServers) register(s *grpc.Server) *health.Server {
+	bookingpb.RegisterBookingServer(s, g.BookingServer)
+	handlingpb.RegisterHandlingServer(s, g.HandlingServer)
+	locatingpb.RegisterLocatingServer(s, g.LocatingServer)
+	schedulingpb.RegisterSchedulingServer(s, g.SchedulingServer)
+	trackingpb.RegisterTrackingServer(s, g.TrackingServer)
+
+	hs := health.NewServer()
+	for name := range s.GetServiceInfo() {
+		hs.SetServingStatus(name, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(s, hs)
+	reflection.Register(s)
+
+	return hs
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/Qalifah/shipping/handling"
+)
+
+// runImport implements the import subcommand, which uploads a CSV file or an
+// EDIFACT IFTSTA interchange of handling events to a running server and
+// prints the resulting report.
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	var (
+		server = fs.String("server", "http://localhost:"+defaultPort, "base URL of the shipping server")
+		format = fs.String("format", "csv", "format of the import file (csv or iftsta)")
+	)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: %s import [flags] <file | ->\n", os.Args[0])
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("missing import file")
+	}
+
+	var path, contentType string
+	switch *format {
+	case "csv":
+		path, contentType = "/handling/v1/events/import", "text/csv"
+	case "iftsta":
+		path, contentType = "/handling/v1/events/iftsta", "application/EDIFACT"
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	var in io.Reader = os.Stdin
+	if name := fs.Arg(0); name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		in = f
+	}
+
+	resp, err := http.Post(strings.TrimSuffix(*server, "/")+path, contentType, in)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Report   handling.ImportReport   `json:"report"`
+		Unmapped []handling.SegmentError `json:"unmapped"`
+		Err      string                  `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return fmt.Errorf("%s: %v", resp.Status, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", resp.Status, body.Err)
+	}
+
+	r := body.Report
+	fmt.Printf("%d rows, %d accepted, %d failed\n", r.Total, r.Accepted, len(r.Failed))
+	for _, e := range r.Failed {
+		fmt.Printf("row %d: %s\n", e.Row, e.Err)
+	}
+	for _, e := range body.Unmapped {
+		fmt.Printf("segment %d (%s): unmapped, %s\n", e.Segment, e.Text, e.Err)
+	}
+	if len(r.Failed) > 0 {
+		return fmt.Errorf("%d rows failed", len(r.Failed))
+	}
+	return nil
+}
@@ -5,73 +5,258 @@ import (
 	"flag"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
+	_ "time/tzdata"
 	"fmt"
+	"net"
 	"net/http"
 
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
 
 	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/go-kit/kit/metrics/discard"
 
+	"github.com/Qalifah/shipping/bolt"
 	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/eventsource"
 	"github.com/Qalifah/shipping/inmem"
 	"github.com/Qalifah/shipping/inspection"
 	"github.com/Qalifah/shipping/location"
 	"github.com/Qalifah/shipping/handling"
 	"github.com/Qalifah/shipping/routing"
+	"github.com/Qalifah/shipping/locating"
+	"github.com/Qalifah/shipping/notifying"
+	"github.com/Qalifah/shipping/pubsub"
+	"github.com/Qalifah/shipping/scheduling"
+	"github.com/Qalifah/shipping/sqldb"
 	"github.com/Qalifah/shipping/booking"
 	"github.com/Qalifah/shipping/tracking"
+	"github.com/Qalifah/shipping/voyage"
+
+	_ "modernc.org/sqlite"
 )
 
 const (
 	defaultPort = "8080"
+	defaultGRPCPort = "8081"
 	defaultRoutingServiceURL = "http://localhost:7878"
+	defaultRouting = "proxy"
+	defaultStore = "inmem"
+	defaultBoltPath = "shipping.db"
+	defaultSQLDriver = "sqlite"
+	defaultSQLDSN = "shipping.sqlite?_pragma=busy_timeout(5000)&_time_format=sqlite"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err := runImport(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var (
 		addr = envString("PORT", defaultPort)
+		grpcaddr = envString("GRPC_PORT", defaultGRPCPort)
 		rsurl = envString("ROUTINGSERVICE_URL", defaultRoutingServiceURL)
+		routingImpl = envString("ROUTING", defaultRouting)
+		storage = envString("STORE", defaultStore)
+		boltpath = envString("BOLT_PATH", defaultBoltPath)
+		sqldriver = envString("SQL_DRIVER", defaultSQLDriver)
+		sqldsn = envString("SQL_DSN", defaultSQLDSN)
 
 		httpAddr = flag.String("http.addr", ":"+addr, "HTTP listen address")
-		routingServiceURL = flag.String("service.routing", rsurl, "routing service URL")
+		grpcAddr = flag.String("grpc.addr", ":"+grpcaddr, "gRPC listen address (empty to disable)")
+		routingServiceURL = flag.String("service.routing", rsurl, "comma-separated routing service instance URLs")
+		routingServiceFile = flag.String("service.routing.file", "", "file listing routing service instance URLs, one per line, watched for changes (overrides -service.routing)")
+		routingService = flag.String("routing", routingImpl, "routing implementation to use (proxy or pathfinder)")
+		routingTimeout = flag.Duration("routing.timeout", 2*time.Second, "deadline of each call to the routing service")
+		routingRetries = flag.Int("routing.retries", 2, "number of retries of a failed call to the routing service")
+		routingCacheTTL = flag.Duration("routing.cache_ttl", 10*time.Minute, "how long routes are kept to fall back on while the routing service is unavailable")
+		store = flag.String("store", storage, "repository implementation to use (inmem, bolt or sql)")
+		boltPath = flag.String("bolt.path", boltpath, "path to the bolt database file")
+		sqlDriver = flag.String("sql.driver", sqldriver, "database/sql driver name")
+		sqlDSN = flag.String("sql.dsn", sqldsn, "database/sql data source name")
+		eventSourced = flag.Bool("eventsource", false, "store cargos and handling events as domain events (inmem and bolt stores only)")
+		notifyWebhooks = flag.String("notify.webhook", "", "comma-separated URLs to post inspection notifications to")
+		notifySpool = flag.String("notify.spool", "", "directory to write inspection notifications into, one file each")
+		notifyAttempts = flag.Int("notify.attempts", 5, "number of attempts of each delivery to a subscription before it is dead-lettered")
+		notifyBackoff = flag.Duration("notify.backoff", 5*time.Second, "wait after the first failed delivery to a subscription, doubled after each further one")
+		notifyAllowHosts = flag.String("notify.allow_hosts", "", "comma-separated callback hosts that subscriptions may use even though they are not public, e.g. localhost")
+		streamRetain = flag.Int("stream.retain", 1000, "number of handling events retained for live tracking streams to resume from")
+		locationFiles = flag.String("locations.import", "", "comma-separated UN/LOCODE CSV files to import into the location repository on startup")
 
 		ctx = context.Background()
 	)
 
 	flag.Parse()
 
+	if *streamRetain < 0 {
+		fmt.Fprintf(os.Stderr, "invalid -stream.retain %d: must not be negative\n", *streamRetain)
+		os.Exit(1)
+	}
+
 	var logger log.Logger
 	logger = log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
 	logger = log.With(logger, "ts", log.DefaultTimestampUTC)
 
 	var (
+		cargos cargo.Repository
+		locations location.Repository
+		voyages voyage.Repository
+		handlingEvents cargo.HandlingEventRepository
+	)
+
+	switch *store {
+	case "inmem":
 		cargos = inmem.NewCargoRepository()
 		locations = inmem.NewLocationRepository()
 		voyages = inmem.NewVoyageRepository()
 		handlingEvents = inmem.NewHandlingEventRepository()
+
+		if *eventSourced {
+			r := eventsource.NewRepository(eventsource.NewInmemStore(), log.With(logger, "component", "eventsource"))
+			cargos, handlingEvents = r, r.HandlingEvents()
+		}
+	case "bolt":
+		db, err := bolt.Open(*boltPath)
+		if err != nil {
+			panic(err)
+		}
+		defer db.Close()
+
+		boltLogger := log.With(logger, "component", "bolt")
+		if cargos, err = bolt.NewCargoRepository(db, boltLogger); err != nil {
+			panic(err)
+		}
+		if locations, err = bolt.NewLocationRepository(db, boltLogger); err != nil {
+			panic(err)
+		}
+		if voyages, err = bolt.NewVoyageRepository(db, boltLogger); err != nil {
+			panic(err)
+		}
+		if handlingEvents, err = bolt.NewHandlingEventRepository(db, boltLogger); err != nil {
+			panic(err)
+		}
+
+		if *eventSourced {
+			events, err := bolt.NewEventStore(db, boltLogger)
+			if err != nil {
+				panic(err)
+			}
+			r := eventsource.NewRepository(events, log.With(logger, "component", "eventsource"))
+			cargos, handlingEvents = r, r.HandlingEvents()
+		}
+	case "sql":
+		if *eventSourced {
+			fmt.Fprintln(os.Stderr, "event sourcing is not supported by the sql store")
+			os.Exit(1)
+		}
+
+		db, err := sqldb.Open(*sqlDriver, *sqlDSN)
+		if err != nil {
+			panic(err)
+		}
+		defer db.Close()
+
+		// Only cargos and handling events have a SQL schema so far.
+		sqlLogger := log.With(logger, "component", "sqldb")
+		cargos = sqldb.NewCargoRepository(db, sqlLogger)
+		locations = inmem.NewLocationRepository()
+		voyages = inmem.NewVoyageRepository()
+		handlingEvents = sqldb.NewHandlingEventRepository(db, sqlLogger)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown store %q\n", *store)
+		os.Exit(1)
+	}
+
+	fieldKeys := []string{"method"}
+
+	var ns notifying.Service
+	ns = notifying.NewService(cargos, nil, *notifyAttempts, *notifyBackoff, strings.Split(*notifyAllowHosts, ","))
+	ns = notifying.NewLoggingService(log.With(logger, "component", "notifying"), ns)
+	ns = notifying.NewInstrumentingService(
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "api",
+			Subsystem: "notifying_service",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, fieldKeys),
+		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+			Namespace: "api",
+			Subsystem: "notifying_service",
+			Name:      "request_latency_microseconds",
+			Help:      "Total duration of requests in microseconds.",
+		}, fieldKeys),
+		ns,
 	)
 
+	hub := pubsub.NewHub(*streamRetain)
+
+	notifier := inspection.NewNotifier(log.With(logger, "component", "notifier"))
+	notifier.Register("log", inspection.NewLogChannel(log.With(logger, "component", "inspection")))
+	if *notifyWebhooks != "" {
+		for _, url := range strings.Split(*notifyWebhooks, ",") {
+			url = strings.TrimSpace(url)
+			notifier.Register("webhook "+url, inspection.NewWebhookChannel(url, nil))
+		}
+	}
+	if *notifySpool != "" {
+		spool, err := inspection.NewSpoolChannel(*notifySpool)
+		if err != nil {
+			logger.Log("spool", *notifySpool, "err", err)
+			os.Exit(1)
+		}
+		notifier.Register("spool", spool)
+	}
+	notifier.Register("subscriptions", notifying.NewInspectionChannel(ns))
+
 	var  (
 		handlingEventFactory = cargo.HandlingEventFactory{
 			CargoRepository: cargos,
 			VoyageRepository: voyages,
 			LocationRepository: locations,
 		}
-		handlingEventHandler = handling.NewEventHandler(
-			inspection.NewService(cargos, handlingEvents, nil),
-		)
+		inspectionService = inspection.NewService(cargos, handlingEvents, notifier)
+		handlingEventHandler = handling.NewPublishingEventHandler(notifying.NewHandlingEventHandler(handling.NewEventHandler(inspectionService), ns), hub)
+		schedulingEventHandler = scheduling.NewEventHandler(inspectionService)
 	)
 
 	storeTestData(cargos)
 
-	fieldKeys := []string{"method"}
-
 	var rs	routing.Service
-	rs = routing.NewProxyingMiddleware(ctx, *routingServiceURL)(rs)
+	switch *routingService {
+	case "proxy":
+		var instancer sd.Instancer
+		if *routingServiceFile != "" {
+			instancer = routing.NewFileInstancer(*routingServiceFile, 5*time.Second, log.With(logger, "component", "routing"))
+		} else {
+			instances := routing.SplitInstances(*routingServiceURL)
+			if len(instances) == 0 {
+				fmt.Fprintln(os.Stderr, "no routing service instances in -service.routing")
+				os.Exit(1)
+			}
+			instancer = sd.FixedInstancer(instances)
+		}
+		rs = routing.NewProxyingMiddleware(ctx, instancer, log.With(logger, "component", "routing"),
+			routing.ProxyTimeout(*routingTimeout),
+			routing.ProxyRetries(*routingRetries, 100*time.Millisecond),
+			routing.ProxyCacheTTL(*routingCacheTTL),
+		)(rs)
+	case "pathfinder":
+		rs = routing.NewPathfinder(voyages)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown routing implementation %q\n", *routingService)
+		os.Exit(1)
+	}
 
 	var bs booking.Service
 	bs = booking.NewService(cargos, locations, handlingEvents, rs)
@@ -95,7 +280,7 @@ func main() {
 	)
 
 	var ts tracking.Service
-	ts = tracking.NewService(cargos, handlingEvents)
+	ts = tracking.NewService(cargos, handlingEvents, locations, hub)
 	ts = tracking.NewLoggingService(log.With(logger, "component", "tracking"), ts)
 	ts = tracking.NewInstrumentingService(
 		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
@@ -132,6 +317,53 @@ func main() {
 		hs,
 	)
 
+	var ss scheduling.Service
+	ss = scheduling.NewService(voyages, locations, schedulingEventHandler)
+	ss = scheduling.NewLoggingService(log.With(logger, "component", "scheduling"), ss)
+	ss = scheduling.NewInstrumentingService(
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "api",
+			Subsystem: "scheduling_service",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, fieldKeys),
+		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+			Namespace: "api",
+			Subsystem: "scheduling_service",
+			Name:      "request_latency_microseconds",
+			Help:      "Total duration of requests in microseconds.",
+		}, fieldKeys),
+		ss,
+	)
+
+	var ls locating.Service
+	ls = locating.NewService(locations)
+	ls = locating.NewLoggingService(log.With(logger, "component", "locating"), ls)
+	ls = locating.NewInstrumentingService(
+		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: "api",
+			Subsystem: "locating_service",
+			Name:      "request_count",
+			Help:      "Number of requests received.",
+		}, fieldKeys),
+		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
+			Namespace: "api",
+			Subsystem: "locating_service",
+			Name:      "request_latency_microseconds",
+			Help:      "Total duration of requests in microseconds.",
+		}, fieldKeys),
+		ls,
+	)
+
+	if *locationFiles != "" {
+		for _, name := range strings.Split(*locationFiles, ",") {
+			if err := importLocations(ls, strings.TrimSpace(name)); err != nil {
+				logger.Log("locations", name, "err", err)
+				os.Exit(1)
+			}
+		}
+	}
+
 	httpLogger := log.With(logger, "component", "http")
 
 	mux := http.NewServeMux()
@@ -139,28 +371,75 @@ func main() {
 	mux.Handle("/booking/v1/", booking.MakeHandler(bs, httpLogger))
 	mux.Handle("/tracking/v1/", tracking.MakeHandler(ts, httpLogger))
 	mux.Handle("/handling/v1/", handling.MakeHandler(hs, httpLogger))
+	mux.Handle("/scheduling/v1/", scheduling.MakeHandler(ss, httpLogger))
+	mux.Handle("/locating/v1/", locating.MakeHandler(ls, httpLogger))
+	mux.Handle("/notifying/v1/", notifying.MakeHandler(ns, httpLogger))
 
 	http.Handle("/", accessControl(mux))
 	http.Handle("/metrics", promhttp.Handler())
 
-	errs := make(chan error, 2)
+	grpcLogger := log.With(logger, "component", "grpc")
+	tracer := stdopentracing.GlobalTracer()
+	duration := discard.NewHistogram()
+
+	grpcServer := grpc.NewServer()
+	healthServer := NewgRPCServers(
+		booking.NewSet(bs, grpcLogger, duration, tracer, nil),
+		handling.NewSet(hs, grpcLogger, duration, tracer, nil),
+		locating.NewSet(ls, grpcLogger, duration, tracer, nil),
+		scheduling.NewSet(ss, grpcLogger, duration, tracer, nil),
+		tracking.NewSet(ts, grpcLogger, duration, tracer, nil),
+		tracer, nil, grpcLogger,
+	).register(grpcServer)
+
+	errs := make(chan error, 3)
 	go func() {
 		logger.Log("transport", "http", "address", *httpAddr, "msg", "listening")
 		errs <- http.ListenAndServe(*httpAddr, nil)
 	}()
+	if *grpcAddr != "" {
+		go func() {
+			ln, err := net.Listen("tcp", *grpcAddr)
+			if err != nil {
+				errs <- err
+				return
+			}
+			logger.Log("transport", "grpc", "address", *grpcAddr, "msg", "listening")
+			errs <- grpcServer.Serve(ln)
+		}()
+	}
 	go func() {
-		c := make(chan os.Signal)
+		c := make(chan os.Signal, 1)
 		signal.Notify(c, syscall.SIGINT)
 		errs <- fmt.Errorf("%s", <-c)
 	}()
 
 	logger.Log("terminated", <-errs)
+
+	healthServer.Shutdown()
+	grpcServer.Stop()
+}
+
+// importLocations imports a file of the UN/LOCODE code list.
+func importLocations(s locating.Service, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	locations, err := location.ReadUNLOCODE(f)
+	if err != nil {
+		return err
+	}
+	_, err = s.ImportLocations(locations)
+	return err
 }
 
 func accessControl(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Access-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
 		w.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type")
 
 		if r.Method == "OPTIONS" {
@@ -180,6 +459,11 @@ func envString(key, fallback string) string {
 }
 
 func storeTestData(r cargo.Repository) {
+	// Persistent stores keep whatever was booked before the last restart.
+	if len(r.FindAll()) > 0 {
+		return
+	}
+
 	test1 := cargo.New("FTL456", cargo.RouteSpecification{
 		Origin:          location.AUMEL,
 		Destination:     location.SESTO,
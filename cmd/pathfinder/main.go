@@ -0,0 +1,142 @@
+// Command pathfinder serves the paths protocol expected by the routing
+// proxy, finding itineraries over voyage schedules. It stands in for the
+// legacy external routing service.
+//
+// The schedules are either read from a store, or kept in sync with those of
+// a running shipping server through its scheduling service. A bolt store
+// cannot be shared with a running server, since bolt locks its file.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
+
+	"github.com/Qalifah/shipping/bolt"
+	"github.com/Qalifah/shipping/inmem"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/routing"
+	"github.com/Qalifah/shipping/scheduling"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+const (
+	defaultPort = "7878"
+	defaultStore = "inmem"
+	defaultBoltPath = "shipping.db"
+)
+
+func main() {
+	var (
+		addr = envString("PORT", defaultPort)
+		storage = envString("STORE", defaultStore)
+		boltpath = envString("BOLT_PATH", defaultBoltPath)
+		ssaddr = envString("SCHEDULINGSERVICE_ADDR", "")
+
+		httpAddr = flag.String("http.addr", ":"+addr, "HTTP listen address")
+		store = flag.String("store", storage, "repository implementation to read voyages from (inmem, bolt or sql)")
+		boltPath = flag.String("bolt.path", boltpath, "path to the bolt database file")
+		schedulingService = flag.String("service.scheduling", ssaddr, "gRPC address of a shipping server to sync voyages from (overrides -store)")
+		schedulingInterval = flag.Duration("scheduling.interval", 30*time.Second, "how often voyages are synced from -service.scheduling")
+	)
+
+	flag.Parse()
+
+	var logger log.Logger
+	logger = log.NewLogfmtLogger(log.NewSyncWriter(os.Stderr))
+	logger = log.With(logger, "ts", log.DefaultTimestampUTC)
+
+	var voyages voyage.Repository
+
+	switch {
+	case *schedulingService != "":
+		conn, err := grpc.Dial(*schedulingService, grpc.WithInsecure())
+		if err != nil {
+			panic(err)
+		}
+		defer conn.Close()
+
+		ss := scheduling.NewGRPCClient(conn, stdopentracing.GlobalTracer(), nil, log.With(logger, "component", "scheduling"))
+		voyages = inmem.NewVoyageRepository()
+		go syncVoyages(ss, voyages, *schedulingInterval, log.With(logger, "component", "sync"))
+	case *store == "inmem", *store == "sql":
+		// Voyages have no SQL schema so far, so the sql store of the shipping
+		// server keeps them in memory too.
+		voyages = inmem.NewVoyageRepository()
+	case *store == "bolt":
+		db, err := bolt.Open(*boltPath)
+		if err != nil {
+			panic(err)
+		}
+		defer db.Close()
+
+		if voyages, err = bolt.NewVoyageRepository(db, log.With(logger, "component", "bolt")); err != nil {
+			panic(err)
+		}
+	default:
+		fmt.Fprintf(os.Stderr, "unknown store %q\n", *store)
+		os.Exit(1)
+	}
+
+	var rs routing.Service
+	rs = routing.NewPathfinder(voyages)
+	rs = routing.NewLoggingService(log.With(logger, "component", "routing"), rs)
+
+	httpLogger := log.With(logger, "component", "http")
+
+	http.Handle("/", routing.MakeHandler(rs, httpLogger))
+
+	errs := make(chan error, 2)
+	go func() {
+		logger.Log("transport", "http", "address", *httpAddr, "msg", "listening")
+		errs <- http.ListenAndServe(*httpAddr, nil)
+	}()
+	go func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+		errs <- fmt.Errorf("%s", <-c)
+	}()
+
+	logger.Log("terminated", <-errs)
+}
+
+// syncVoyages stores the voyages of the scheduling service in the repository
+// now and at every interval. Voyages are only ever added or updated, so that
+// the last known schedules are kept while the service is unavailable.
+func syncVoyages(s scheduling.Service, r voyage.Repository, interval time.Duration, logger log.Logger) {
+	for {
+		vs := s.Voyages()
+		for _, v := range vs {
+			movements := make([]voyage.CarrierMovement, 0, len(v.Movements))
+			for _, m := range v.Movements {
+				movements = append(movements, voyage.CarrierMovement{
+					DepartureLocation: location.UNLcode(m.DepartureLocation),
+					ArrivalLocation:   location.UNLcode(m.ArrivalLocation),
+					DepartureTime:     m.DepartureTime,
+					ArrivalTime:       m.ArrivalTime,
+				})
+			}
+			if err := r.Store(voyage.New(voyage.Number(v.VoyageNumber), voyage.Schedule{CarrierMovements: movements})); err != nil {
+				logger.Log("voyage", v.VoyageNumber, "err", err)
+			}
+		}
+		logger.Log("voyages", len(vs))
+		time.Sleep(interval)
+	}
+}
+
+func envString(key, fallback string) string {
+	e := os.Getenv(key)
+	if e == "" {
+		return fallback
+	}
+	return e
+}
@@ -0,0 +1,142 @@
+// Package edifact provides a minimal reader for UN/EDIFACT interchanges. It
+// splits an interchange into segments, elements and components, honouring the
+// service string advice (UNA) and the release character, but knows nothing
+// about the meaning of any particular message.
+package edifact
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// ErrUnterminated is returned when the interchange ends in the middle of a
+// segment.
+var ErrUnterminated = errors.New("unterminated segment")
+
+// ErrInvalidUNA is returned when the service string advice is too short.
+var ErrInvalidUNA = errors.New("invalid UNA segment")
+
+// Delimiters are the service characters of an interchange.
+type Delimiters struct {
+	Component byte
+	Element   byte
+	Decimal   byte
+	Release   byte
+	Segment   byte
+}
+
+// DefaultDelimiters are used when an interchange has no UNA segment.
+var DefaultDelimiters = Delimiters{
+	Component: ':',
+	Element:   '+',
+	Decimal:   '.',
+	Release:   '?',
+	Segment:   '\'',
+}
+
+// Segment is a single segment of an interchange. Elements holds the data
+// elements following the tag, each split into its components.
+type Segment struct {
+	Position int
+	Tag      string
+	Elements [][]string
+}
+
+// Component returns the j-th component of the i-th data element, counting
+// from zero, or "" if the segment has no such component.
+func (s Segment) Component(i, j int) string {
+	if i >= len(s.Elements) || j >= len(s.Elements[i]) {
+		return ""
+	}
+	return s.Elements[i][j]
+}
+
+// String renders the segment with the default delimiters.
+func (s Segment) String() string {
+	var b strings.Builder
+	b.WriteString(s.Tag)
+	for _, e := range s.Elements {
+		b.WriteByte(DefaultDelimiters.Element)
+		for j, c := range e {
+			if j > 0 {
+				b.WriteByte(DefaultDelimiters.Component)
+			}
+			b.WriteString(c)
+		}
+	}
+	return b.String()
+}
+
+// Read reads all segments of an interchange. Segments are numbered from 1 in
+// their Position, not counting UNA, which is consumed. Line breaks between
+// segments are ignored.
+func Read(r io.Reader) ([]Segment, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(string(b))
+}
+
+// Parse splits an interchange into segments, see Read.
+func Parse(s string) ([]Segment, error) {
+	d := DefaultDelimiters
+	s = strings.TrimLeft(s, " \t\r\n")
+	if strings.HasPrefix(s, "UNA") {
+		if len(s) < 9 {
+			return nil, ErrInvalidUNA
+		}
+		d = Delimiters{
+			Component: s[3],
+			Element:   s[4],
+			Decimal:   s[5],
+			Release:   s[6],
+			Segment:   s[8],
+		}
+		s = s[9:]
+	}
+
+	var (
+		segments []Segment
+		elements [][]string
+		comps    []string
+		buf      strings.Builder
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == d.Release && d.Release != ' ':
+			i++
+			if i == len(s) {
+				return nil, ErrUnterminated
+			}
+			buf.WriteByte(s[i])
+		case c == d.Component:
+			comps = append(comps, buf.String())
+			buf.Reset()
+		case c == d.Element:
+			elements = append(elements, append(comps, buf.String()))
+			comps = nil
+			buf.Reset()
+		case c == d.Segment:
+			elements = append(elements, append(comps, buf.String()))
+			segments = append(segments, Segment{
+				Position: len(segments) + 1,
+				Tag:      strings.TrimSpace(elements[0][0]),
+				Elements: elements[1:],
+			})
+			elements, comps = nil, nil
+			buf.Reset()
+		case (c == '\r' || c == '\n') && buf.Len() == 0 && len(comps) == 0 && len(elements) == 0:
+			// Line breaks between segments.
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	if len(elements) > 0 || len(comps) > 0 || strings.TrimSpace(buf.String()) != "" {
+		return nil, ErrUnterminated
+	}
+	return segments, nil
+}
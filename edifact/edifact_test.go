@@ -0,0 +1,106 @@
+package edifact
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		in   string
+		want []Segment
+	}{
+		{
+			name: "default delimiters",
+			in:   "UNH+1+IFTSTA:D:01B:UN'CNI+1+ABC123'",
+			want: []Segment{
+				{Position: 1, Tag: "UNH", Elements: [][]string{{"1"}, {"IFTSTA", "D", "01B", "UN"}}},
+				{Position: 2, Tag: "CNI", Elements: [][]string{{"1"}, {"ABC123"}}},
+			},
+		},
+		{
+			name: "service string advice",
+			in:   "UNA*|.\\ ~UNH|1|IFTSTA*D~LOC|175|SESTO~",
+			want: []Segment{
+				{Position: 1, Tag: "UNH", Elements: [][]string{{"1"}, {"IFTSTA", "D"}}},
+				{Position: 2, Tag: "LOC", Elements: [][]string{{"175"}, {"SESTO"}}},
+			},
+		},
+		{
+			name: "release character",
+			in:   "FTX+AAI+++Don?'t?:stack?+?? 10?''",
+			want: []Segment{
+				{Position: 1, Tag: "FTX", Elements: [][]string{{"AAI"}, {""}, {""}, {"Don't:stack+? 10'"}}},
+			},
+		},
+		{
+			name: "release character of a service string advice",
+			in:   "UNA:+.! 'FTX+AAI+50!+ boxes!'s'",
+			want: []Segment{
+				{Position: 1, Tag: "FTX", Elements: [][]string{{"AAI"}, {"50+ boxes's"}}},
+			},
+		},
+		{
+			name: "composite and repeated elements",
+			in:   "DTM+334:203001011200:203'STS+1+UV::ZZ+++A:B:C+A:B:C'",
+			want: []Segment{
+				{Position: 1, Tag: "DTM", Elements: [][]string{{"334", "203001011200", "203"}}},
+				{Position: 2, Tag: "STS", Elements: [][]string{{"1"}, {"UV", "", "ZZ"}, {""}, {""}, {"A", "B", "C"}, {"A", "B", "C"}}},
+			},
+		},
+		{
+			name: "line breaks between segments",
+			in:   "\r\nUNH+1'\r\nUNT+2+1'\n",
+			want: []Segment{
+				{Position: 1, Tag: "UNH", Elements: [][]string{{"1"}}},
+				{Position: 2, Tag: "UNT", Elements: [][]string{{"2"}, {"1"}}},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Read(strings.NewReader(tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		in   string
+		want error
+	}{
+		{name: "short service string advice", in: "UNA:+.", want: ErrInvalidUNA},
+		{name: "unterminated segment", in: "UNH+1'CNI+1", want: ErrUnterminated},
+		{name: "trailing release character", in: "UNH+1?", want: ErrUnterminated},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.in); err != tt.want {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegment(t *testing.T) {
+	s := Segment{Tag: "DTM", Elements: [][]string{{"334", "203001011200", "203"}}}
+	if got := s.Component(0, 1); got != "203001011200" {
+		t.Errorf("got %q, want 203001011200", got)
+	}
+	if got := s.Component(1, 0); got != "" {
+		t.Errorf("got %q for a missing element, want none", got)
+	}
+	if got := s.Component(0, 3); got != "" {
+		t.Errorf("got %q for a missing component, want none", got)
+	}
+	if got := s.String(); got != "DTM+334:203001011200:203" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,177 @@
+// Package eventsource provides event-sourced implementations of the cargo and
+// handling event repositories. Nothing but the domain events is persisted;
+// cargos, including their delivery, are rebuilt by replaying them.
+package eventsource
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/Qalifah/shipping/cargo"
+)
+
+// AnyVersion disables the concurrency check of Store.Append.
+const AnyVersion = -1
+
+// Store provides access to an append-only store of cargo domain events.
+type Store interface {
+	// Append adds events to the end of the stream of the given cargo,
+	// assigning their sequence numbers. Unless expected is AnyVersion, it
+	// fails with a *cargo.ConflictError if the stream does not currently
+	// hold exactly expected events.
+	Append(id cargo.TrackingID, expected int, events ...cargo.Event) error
+
+	// Load returns the complete stream of the given cargo, in order.
+	Load(id cargo.TrackingID) ([]cargo.Event, error)
+
+	// TrackingIDs returns the IDs of all cargos with a stream.
+	TrackingIDs() []cargo.TrackingID
+}
+
+type inmemStore struct {
+	mtx     sync.RWMutex
+	streams map[cargo.TrackingID][]cargo.Event
+}
+
+func (s *inmemStore) Append(id cargo.TrackingID, expected int, events ...cargo.Event) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if current := len(s.streams[id]); expected != AnyVersion && expected != current {
+		return &cargo.ConflictError{TrackingID: id, Expected: expected, Actual: current}
+	}
+	for _, e := range events {
+		e.Sequence = len(s.streams[id]) + 1
+		s.streams[id] = append(s.streams[id], e)
+	}
+	return nil
+}
+
+func (s *inmemStore) Load(id cargo.TrackingID) ([]cargo.Event, error) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	events := make([]cargo.Event, len(s.streams[id]))
+	copy(events, s.streams[id])
+	return events, nil
+}
+
+func (s *inmemStore) TrackingIDs() []cargo.TrackingID {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	ids := make([]cargo.TrackingID, 0, len(s.streams))
+	for id := range s.streams {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// NewInmemStore returns a new instance of an in-memory event store.
+func NewInmemStore() Store {
+	return &inmemStore{
+		streams: make(map[cargo.TrackingID][]cargo.Event),
+	}
+}
+
+// Repository is both a cargo repository and a handling event repository
+// backed by an event store.
+type Repository struct {
+	store  Store
+	logger log.Logger
+}
+
+// NewRepository returns a new instance of an event-sourced repository.
+// Errors that the repository interfaces cannot return are logged.
+func NewRepository(s Store, logger log.Logger) *Repository {
+	return &Repository{store: s, logger: logger}
+}
+
+// Store appends the domain events recorded on the cargo to its stream. The
+// version of a replayed cargo is the length of its stream, so any event
+// appended since it was found is reported as a conflict.
+func (r *Repository) Store(c *cargo.Cargo) error {
+	changes := c.Changes()
+	if len(changes) == 0 {
+		return nil
+	}
+	if err := r.store.Append(c.TrackingID, c.Version, changes...); err != nil {
+		return err
+	}
+	c.Version += len(changes)
+	c.ClearChanges()
+	return nil
+}
+
+// Find rebuilds the cargo by replaying its stream.
+func (r *Repository) Find(id cargo.TrackingID) (*cargo.Cargo, error) {
+	events, err := r.store.Load(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, cargo.ErrUnknown
+	}
+	return cargo.Replay(events)
+}
+
+// FindAll rebuilds every cargo in the store.
+func (r *Repository) FindAll() []*cargo.Cargo {
+	c := make([]*cargo.Cargo, 0)
+	for _, id := range r.store.TrackingIDs() {
+		val, err := r.Find(id)
+		if err != nil {
+			r.logger.Log("method", "find_all", "tracking_id", id, "err", err)
+			continue
+		}
+		c = append(c, val)
+	}
+	return c
+}
+
+// Events returns the complete stream of domain events of a cargo, which
+// serves as its audit trail.
+func (r *Repository) Events(id cargo.TrackingID) ([]cargo.Event, error) {
+	return r.store.Load(id)
+}
+
+// StoreHandlingEvent appends the handling event to the stream of the cargo
+// it was registered for.
+func (r *Repository) StoreHandlingEvent(e cargo.HandlingEvent) {
+	if err := r.store.Append(e.TrackingID, AnyVersion, cargo.Event{
+		TrackingID:    e.TrackingID,
+		Type:          cargo.Handled,
+		Occurred:      time.Now(),
+		HandlingEvent: e,
+	}); err != nil {
+		r.logger.Log("method", "store_handling_event", "tracking_id", e.TrackingID, "err", err)
+	}
+}
+
+// QueryHandlingHistory returns the handling events in the stream of a cargo.
+func (r *Repository) QueryHandlingHistory(id cargo.TrackingID) cargo.HandlingHistory {
+	events, err := r.store.Load(id)
+	if err != nil {
+		r.logger.Log("method", "query_handling_history", "tracking_id", id, "err", err)
+	}
+	h := make([]cargo.HandlingEvent, 0)
+	for _, e := range events {
+		if e.Type == cargo.Handled {
+			h = append(h, e.HandlingEvent)
+		}
+	}
+	return cargo.NewHandlingHistory(h)
+}
+
+// HandlingEvents returns a view of the repository as a handling event
+// repository.
+func (r *Repository) HandlingEvents() cargo.HandlingEventRepository {
+	return handlingEventRepository{r}
+}
+
+type handlingEventRepository struct {
+	*Repository
+}
+
+func (r handlingEventRepository) Store(e cargo.HandlingEvent) {
+	r.StoreHandlingEvent(e)
+}
@@ -0,0 +1,115 @@
+package eventsource
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+)
+
+var rs = cargo.RouteSpecification{
+	Origin:      location.SESTO,
+	Destination: location.CNHKG,
+	Deadline:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func TestRepositoryStore(t *testing.T) {
+	r := NewRepository(NewInmemStore(), log.NewNopLogger())
+
+	c := cargo.New("ABC123", rs)
+	if err := r.Store(c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Version != 1 || len(c.Changes()) != 0 {
+		t.Errorf("got version %d with %d changes, want version 1 with none", c.Version, len(c.Changes()))
+	}
+
+	found, err := r.Find("ABC123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.RouteSpecification != rs || found.Version != 1 {
+		t.Errorf("found %+v, want %+v", found, c)
+	}
+	if _, err := r.Find("NOPE"); err != cargo.ErrUnknown {
+		t.Errorf("finding an unknown cargo: got %v, want %v", err, cargo.ErrUnknown)
+	}
+}
+
+func TestRepositoryStoreConflict(t *testing.T) {
+	r := NewRepository(NewInmemStore(), log.NewNopLogger())
+	if err := r.Store(cargo.New("ABC123", rs)); err != nil {
+		t.Fatal(err)
+	}
+
+	first, _ := r.Find("ABC123")
+	second, _ := r.Find("ABC123")
+
+	first.SpecifyNewRoute(rs)
+	if err := r.Store(first); err != nil {
+		t.Fatal(err)
+	}
+	second.SpecifyNewRoute(rs)
+	if err := r.Store(second); !cargo.IsConflict(err) {
+		t.Errorf("storing a stale cargo: got %v, want a conflict", err)
+	}
+
+	// A new cargo whose tracking ID is already taken conflicts too.
+	if err := r.Store(cargo.New("ABC123", rs)); !cargo.IsConflict(err) {
+		t.Errorf("storing a duplicate cargo: got %v, want a conflict", err)
+	}
+
+	// Handling events are appended regardless of the version.
+	r.HandlingEvents().Store(cargo.HandlingEvent{TrackingID: "ABC123", Activity: cargo.HandlingActivity{Type: cargo.Receive, Location: location.SESTO}})
+	if n := len(r.QueryHandlingHistory("ABC123").HandlingEvents); n != 1 {
+		t.Errorf("got %d handling events, want 1", n)
+	}
+	c, err := r.Find("ABC123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Version != 3 {
+		t.Errorf("version: got %d, want 3", c.Version)
+	}
+}
+
+type failingStore struct{ Store }
+
+var errFailing = errors.New("failing")
+
+func (failingStore) Append(cargo.TrackingID, int, ...cargo.Event) error { return errFailing }
+func (failingStore) Load(cargo.TrackingID) ([]cargo.Event, error)       { return nil, errFailing }
+func (failingStore) TrackingIDs() []cargo.TrackingID                    { return []cargo.TrackingID{"ABC123"} }
+
+func TestRepositoryLogsErrors(t *testing.T) {
+	var logged []string
+	logger := log.LoggerFunc(func(keyvals ...interface{}) error {
+		for i := 0; i < len(keyvals); i += 2 {
+			if keyvals[i] == "method" {
+				logged = append(logged, keyvals[i+1].(string))
+			}
+		}
+		return nil
+	})
+	r := NewRepository(failingStore{}, logger)
+
+	r.HandlingEvents().Store(cargo.HandlingEvent{TrackingID: "ABC123"})
+	r.QueryHandlingHistory("ABC123")
+	if n := len(r.FindAll()); n != 0 {
+		t.Errorf("found %d cargos, want none", n)
+	}
+
+	want := []string{"store_handling_event", "query_handling_history", "find_all"}
+	if len(logged) != len(want) {
+		t.Fatalf("logged %v, want %v", logged, want)
+	}
+	for i := range want {
+		if logged[i] != want[i] {
+			t.Errorf("logged %v, want %v", logged, want)
+		}
+	}
+}
@@ -28,6 +28,7 @@ type registerEventRequest struct {
 	Voyage		voyage.Number
 	EventType	cargo.HandlingEventType
 	CompletionTime	time.Time
+	EventID		string
 }
 
 type registerEventResponse struct {
@@ -39,14 +40,54 @@ func (r registerEventResponse) error() error {return r.Err }
 func makeRegisterEventEndpoint(s Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(registerEventRequest)
-		err := s.RegisterHandlingEvent(req.CompletionTime, req.ID, req.Voyage, req.Location, req.EventType)
+		err := s.RegisterHandlingEvent(req.CompletionTime, req.ID, req.Voyage, req.Location, req.EventType, req.EventID)
 		return registerEventResponse{Err : err}, nil
 	}
 }
 
+type importEventsRequest struct {
+	Records		[]Record
+	Rejected	[]RowError
+}
+
+type importEventsResponse struct {
+	Report		ImportReport	`json:"report"`
+}
+
+func makeImportEventsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(importEventsRequest)
+		report := s.ImportHandlingEvents(req.Records)
+		report.reject(req.Rejected...)
+		return importEventsResponse{Report: report}, nil
+	}
+}
+
+type ingestIFTSTARequest struct {
+	Records		[]Record
+	Unmapped	[]SegmentError
+}
+
+type ingestIFTSTAResponse struct {
+	Report		ImportReport	`json:"report"`
+	Unmapped	[]SegmentError	`json:"unmapped"`
+}
+
+func makeIngestIFTSTAEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ingestIFTSTARequest)
+		unmapped := req.Unmapped
+		if unmapped == nil {
+			unmapped = make([]SegmentError, 0)
+		}
+		return ingestIFTSTAResponse{Report: s.ImportHandlingEvents(req.Records), Unmapped: unmapped}, nil
+	}
+}
+
 // Set collects all of the endpoints that compose a handling cargo service.
 type Set struct {
 	RegisterEventEndpoint		endpoint.Endpoint
+	ImportEventsEndpoint		endpoint.Endpoint
 }
 
 // NewSet returns a Set that wraps the provided server, and wires in all of the
@@ -61,17 +102,41 @@ func NewSet(svc Service, logger log.Logger, duration metrics.Histogram, otTracer
 			registerEventEndpoint = zipkin.TraceEndpoint(zipkinTracer, "RequestEvent")(registerEventEndpoint)
 		}
 	}
+	var importEventsEndpoint endpoint.Endpoint
+	{
+		importEventsEndpoint = makeImportEventsEndpoint(svc)
+		importEventsEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 10))(importEventsEndpoint)
+		importEventsEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(importEventsEndpoint)
+		if zipkinTracer != nil {
+			importEventsEndpoint = zipkin.TraceEndpoint(zipkinTracer, "ImportEvents")(importEventsEndpoint)
+		}
+	}
 	return Set{
 		RegisterEventEndpoint: registerEventEndpoint,
+		ImportEventsEndpoint: importEventsEndpoint,
 	}
 }
 
 // RegisterHandlingEvent implements the service interface so Set can be used as a service
-func(s Set) RegisterHandlingEvent(completed time.Time, id cargo.TrackingID, voyageNumber voyage.Number, unLcode location.UNLcode, eventType cargo.HandlingEventType) error {
-	resp, err := s.RegisterEventEndpoint(context.Background(), registerEventRequest{ID: id, Location: unLcode, Voyage: voyageNumber, EventType: eventType, CompletionTime: completed})
+func(s Set) RegisterHandlingEvent(completed time.Time, id cargo.TrackingID, voyageNumber voyage.Number, unLcode location.UNLcode, eventType cargo.HandlingEventType, eventID string) error {
+	resp, err := s.RegisterEventEndpoint(context.Background(), registerEventRequest{ID: id, Location: unLcode, Voyage: voyageNumber, EventType: eventType, CompletionTime: completed, EventID: eventID})
 	if err != nil {
 		return err
 	}
 	response := resp.(registerEventResponse)
 	return response.Err
+}
+
+// ImportHandlingEvents implements the service interface so Set can be used as a service
+func(s Set) ImportHandlingEvents(records []Record) ImportReport {
+	resp, err := s.ImportEventsEndpoint(context.Background(), importEventsRequest{Records: records})
+	if err != nil {
+		report := ImportReport{Failed: make([]RowError, 0)}
+		for _, r := range records {
+			report.reject(RowError{Row: r.Row, Err: err.Error()})
+		}
+		return report
+	}
+	response := resp.(importEventsResponse)
+	return response.Report
 }
\ No newline at end of file
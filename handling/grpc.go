@@ -47,7 +47,7 @@ func NewGRPCServer(endpoints Set, otTracer stdopentracing.Tracer, zipkinTracer *
 			endpoints.RegisterEventEndpoint,
 			decodeGRPCRegisterEventRequest,
 			encodeGRPCRegisterEventResponse,
-			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "bookCargo", logger)))...,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "registerEvent", logger)))...,
 		),
 	}
 }
@@ -71,8 +71,8 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 	{
 		registerEventEndpoint = grpctransport.NewClient(
 			conn,
-			"pb.Handling",
-			"RegisterEvent",
+			"handlingpb.Handling",
+			"RegisterHandlingEvent",
 			encodeGRPCRegisterEventRequest,
 			decodeGRPCRegisterEventResponse,
 			pb.RegisterHandlingEventReply{},
@@ -98,7 +98,8 @@ func decodeGRPCRegisterEventRequest(_ context.Context, grpcReq interface{}) (int
 		Location: location.UNLcode(req.Location), 
 		Voyage: voyage.Number(req.VoyageNumber), 
 		EventType: cargo.HandlingEventType(req.EventType), 
-		CompletionTime: completionTime}, nil
+		CompletionTime: completionTime,
+		EventID: req.EventId}, nil
 }
 
 func encodeGRPCRegisterEventResponse(_ context.Context, response interface{}) (interface{}, error) {
@@ -115,6 +116,7 @@ func encodeGRPCRegisterEventRequest(_ context.Context, request interface{}) (int
 		VoyageNumber: string(req.Voyage),
 		Location: string(req.Location),
 		EventType: int64(req.EventType),
+		EventId: req.EventID,
 	}, nil
 }
 
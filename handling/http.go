@@ -13,6 +13,7 @@ import (
 	"github.com/go-kit/kit/transport"
 
 	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/edifact"
 	"github.com/Qalifah/shipping/location"
 	"github.com/Qalifah/shipping/voyage"
 )
@@ -33,7 +34,23 @@ func MakeHandler(s Service, logger kitlog.Logger) http.Handler {
 		opts...,
 	)
 
+	importEventsHandler := kithttp.NewServer(
+		makeImportEventsEndpoint(s),
+		decodeImportEventsRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	ingestIFTSTAHandler := kithttp.NewServer(
+		makeIngestIFTSTAEndpoint(s),
+		decodeIngestIFTSTARequest,
+		encodeResponse,
+		opts...,
+	)
+
 	r.Handle("/handling/v1/events", registerEventHandler).Methods("POST")
+	r.Handle("/handling/v1/events/import", importEventsHandler).Methods("POST")
+	r.Handle("/handling/v1/events/iftsta", ingestIFTSTAHandler).Methods("POST")
 
 	return r
 }
@@ -45,6 +62,7 @@ func decodeRegisterEventRequest(_ context.Context, r *http.Request) (interface{}
 		VoyageNumber   string    `json:"voyage"`
 		Location       string    `json:"location"`
 		EventType      string    `json:"event_type"`
+		EventID        string    `json:"event_id"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
@@ -57,9 +75,26 @@ func decodeRegisterEventRequest(_ context.Context, r *http.Request) (interface{}
 		Voyage: 	voyage.Number(body.VoyageNumber),
 		EventType:	stringToEventType(body.EventType),
 		CompletionTime : body.CompletionTime,
+		EventID:	body.EventID,
 	}, nil
 }
 
+func decodeImportEventsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	records, rejected, err := ReadCSV(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return importEventsRequest{Records: records, Rejected: rejected}, nil
+}
+
+func decodeIngestIFTSTARequest(_ context.Context, r *http.Request) (interface{}, error) {
+	records, unmapped, err := ReadIFTSTA(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	return ingestIFTSTARequest{Records: records, Unmapped: unmapped}, nil
+}
+
 func stringToEventType(s string) cargo.HandlingEventType {
 	types := map[string]cargo.HandlingEventType{
 		cargo.Receive.String(): cargo.Receive,
@@ -91,8 +126,10 @@ func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 	switch err {
 	case cargo.ErrUnknown:
 		w.WriteHeader(http.StatusNotFound)
-	case ErrInvalidArgument:
+	case ErrInvalidArgument, ErrImplausibleLocation, edifact.ErrUnterminated, edifact.ErrInvalidUNA:
 		w.WriteHeader(http.StatusBadRequest)
+	case ErrEventIDReused:
+		w.WriteHeader(http.StatusConflict)
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 	}
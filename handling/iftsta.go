@@ -0,0 +1,192 @@
+package handling
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/edifact"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+// IFTSTAStatusCodes maps the status codes of STS segments to the handling
+// event types they report. Partners using other codes can be supported by
+// adding to it.
+var IFTSTAStatusCodes = map[string]cargo.HandlingEventType{
+	"I":  cargo.Receive,
+	"AE": cargo.Load,
+	"UV": cargo.Unload,
+	"CR": cargo.Customs,
+	"OA": cargo.Claim,
+}
+
+// the qualifiers of the segments a status is read from
+const (
+	activityLocation = "175"
+	statusDateTime   = "334"
+	mainCarriage     = "20"
+)
+
+var dateTimeFormats = map[string]string{
+	"102": "20060102",
+	"203": "200601021504",
+	"204": "20060102150405",
+}
+
+// ignoredSegments carry nothing a handling event is made of.
+var ignoredSegments = map[string]bool{
+	"UNG": true, "UNE": true, "UNZ": true,
+	"BGM": true, "NAD": true, "RFF": true, "FTX": true, "EQD": true, "CNT": true,
+}
+
+// SegmentError describes a segment of an IFTSTA interchange that could not
+// be mapped to a handling event.
+type SegmentError struct {
+	Segment int    `json:"segment"`
+	Text    string `json:"text"`
+	Err     string `json:"error"`
+}
+
+// ReadIFTSTA reads handling events from the IFTSTA messages of an EDIFACT
+// interchange. Each STS segment within a consignment (CNI) yields a record,
+// located by the following LOC+175, timed by DTM+334 and, optionally, linked
+// to a voyage by TDT+20. The Row of a record is the position of its STS
+// segment, and its event ID is derived from the interchange reference so
+// that a resent interchange is not registered twice. Segments that cannot be
+// mapped, including LOC, DTM and TDT segments that do not follow a valid
+// status, are reported rather than failing the whole interchange.
+func ReadIFTSTA(r io.Reader) ([]Record, []SegmentError, error) {
+	segments, err := edifact.Read(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		records  []Record
+		unmapped []SegmentError
+
+		interchange string
+		skip        bool
+		trackingID  cargo.TrackingID
+		pending     *Record
+		status      edifact.Segment
+	)
+
+	reject := func(s edifact.Segment, format string, args ...interface{}) {
+		unmapped = append(unmapped, SegmentError{Segment: s.Position, Text: s.String(), Err: fmt.Sprintf(format, args...)})
+	}
+	flush := func() {
+		switch {
+		case pending == nil:
+		case pending.Location == "":
+			reject(status, "status has no activity location (LOC+%s)", activityLocation)
+		case pending.CompletionTime.IsZero():
+			reject(status, "status has no date/time (DTM+%s)", statusDateTime)
+		default:
+			records = append(records, *pending)
+		}
+		pending = nil
+	}
+
+	for _, s := range segments {
+		if skip && s.Tag != "UNT" {
+			continue
+		}
+
+		switch s.Tag {
+		case "UNB":
+			flush()
+			interchange = s.Component(1, 0) + ":" + s.Component(4, 0)
+		case "UNH":
+			flush()
+			trackingID = ""
+			if typ := s.Component(1, 0); typ != "IFTSTA" {
+				reject(s, "unsupported message type %q", typ)
+				skip = true
+			}
+		case "UNT":
+			flush()
+			trackingID = ""
+			skip = false
+		case "CNI":
+			flush()
+			trackingID = cargo.TrackingID(s.Component(1, 0))
+			if trackingID == "" {
+				reject(s, "consignment has no reference")
+			}
+		case "STS":
+			flush()
+			code := s.Component(1, 0)
+			eventType, ok := IFTSTAStatusCodes[code]
+			switch {
+			case !ok:
+				reject(s, "unknown status code %q", code)
+			case trackingID == "":
+				reject(s, "status outside of a consignment")
+			default:
+				status = s
+				pending = &Record{
+					Row:        s.Position,
+					TrackingID: trackingID,
+					EventType:  eventType,
+					EventID:    fmt.Sprintf("iftsta:%s:%d", interchange, s.Position),
+				}
+			}
+		case "LOC":
+			if pending == nil {
+				reject(s, "segment outside of a status (STS)")
+				continue
+			}
+			if q := s.Component(0, 0); q != activityLocation {
+				reject(s, "unsupported location qualifier %q", q)
+				continue
+			}
+			pending.Location = location.UNLcode(s.Component(1, 0))
+		case "DTM":
+			if pending == nil {
+				reject(s, "segment outside of a status (STS)")
+				continue
+			}
+			if q := s.Component(0, 0); q != statusDateTime {
+				reject(s, "unsupported date/time qualifier %q", q)
+				continue
+			}
+			format := s.Component(0, 2)
+			if format == "" {
+				format = "203"
+			}
+			layout, ok := dateTimeFormats[format]
+			if !ok {
+				reject(s, "unsupported date/time format %q", format)
+				continue
+			}
+			t, err := time.Parse(layout, s.Component(0, 1))
+			if err != nil {
+				reject(s, "invalid date/time %q", s.Component(0, 1))
+				continue
+			}
+			pending.CompletionTime = t
+		case "TDT":
+			if pending == nil {
+				reject(s, "segment outside of a status (STS)")
+				continue
+			}
+			if q := s.Component(0, 0); q != mainCarriage {
+				reject(s, "unsupported transport stage %q", q)
+				continue
+			}
+			pending.VoyageNumber = voyage.Number(s.Component(1, 0))
+		default:
+			if !ignoredSegments[s.Tag] {
+				reject(s, "unsupported segment")
+			}
+		}
+	}
+	flush()
+
+	sort.SliceStable(unmapped, func(i, j int) bool { return unmapped[i].Segment < unmapped[j].Segment })
+	return records, unmapped, nil
+}
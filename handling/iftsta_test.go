@@ -0,0 +1,148 @@
+package handling
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+)
+
+// iftsta wraps segments into an interchange with a single IFTSTA message.
+// The segments are numbered from 4.
+func iftsta(segments ...string) string {
+	return "UNB+UNOC:3+SENDER+RECEIVER+300101:1200+REF1'" +
+		"UNH+1+IFTSTA:D:01B:UN'" +
+		"CNI+1+ABC123'" +
+		strings.Join(segments, "") +
+		"UNT+9+1'UNZ+1+REF1'"
+}
+
+func TestReadIFTSTAStatusCodes(t *testing.T) {
+	for code, want := range map[string]cargo.HandlingEventType{
+		"I":  cargo.Receive,
+		"AE": cargo.Load,
+		"UV": cargo.Unload,
+		"CR": cargo.Customs,
+		"OA": cargo.Claim,
+	} {
+		t.Run(code, func(t *testing.T) {
+			records, unmapped, err := ReadIFTSTA(strings.NewReader(iftsta(
+				"STS+1+"+code+"'",
+				"LOC+175+SESTO'",
+				"DTM+334:203001021230:203'",
+				"TDT+20+V100'",
+			)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(unmapped) != 0 {
+				t.Errorf("got unmapped segments %+v", unmapped)
+			}
+			if len(records) != 1 {
+				t.Fatalf("got %d records, want 1", len(records))
+			}
+			r := records[0]
+			if r.EventType != want || r.TrackingID != "ABC123" || r.Location != location.SESTO || r.VoyageNumber != "V100" {
+				t.Errorf("got %+v, want a %v of ABC123 at SESTO on V100", r, want)
+			}
+			if !r.CompletionTime.Equal(time.Date(2030, 1, 2, 12, 30, 0, 0, time.UTC)) {
+				t.Errorf("got completion time %v", r.CompletionTime)
+			}
+			if r.Row != 4 || r.EventID != "iftsta:SENDER:REF1:4" {
+				t.Errorf("got row %d and event ID %q, want 4 and iftsta:SENDER:REF1:4", r.Row, r.EventID)
+			}
+		})
+	}
+}
+
+func TestReadIFTSTAUnmapped(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		segments []string
+		records  int
+		unmapped []int
+	}{
+		{
+			name:     "location outside of a status",
+			segments: []string{"LOC+175+SESTO'", "STS+1+I'", "LOC+175+SESTO'", "DTM+334:20300101:102'"},
+			records:  1,
+			unmapped: []int{4},
+		},
+		{
+			name:     "date/time outside of a status",
+			segments: []string{"DTM+334:20300101:102'", "STS+1+I'", "LOC+175+SESTO'", "DTM+334:20300101:102'"},
+			records:  1,
+			unmapped: []int{4},
+		},
+		{
+			name:     "transport outside of a status",
+			segments: []string{"TDT+20+V100'", "STS+1+I'", "LOC+175+SESTO'", "DTM+334:20300101:102'"},
+			records:  1,
+			unmapped: []int{4},
+		},
+		{
+			name:     "segments following an unknown status",
+			segments: []string{"STS+1+XX'", "LOC+175+SESTO'", "DTM+334:20300101:102'", "TDT+20+V100'"},
+			unmapped: []int{4, 5, 6, 7},
+		},
+		{
+			name:     "status without a location",
+			segments: []string{"STS+1+I'", "DTM+334:20300101:102'"},
+			unmapped: []int{4},
+		},
+		{
+			name:     "status without a date/time",
+			segments: []string{"STS+1+I'", "LOC+175+SESTO'"},
+			unmapped: []int{4},
+		},
+		{
+			name:     "unsupported qualifiers and formats",
+			segments: []string{"STS+1+I'", "LOC+5+SESTO'", "LOC+175+SESTO'", "DTM+137:20300101:102'", "DTM+334:2030:999'", "DTM+334:20300101:102'", "TDT+10+V100'"},
+			records:  1,
+			unmapped: []int{5, 7, 8, 10},
+		},
+		{
+			name:     "unsupported segment",
+			segments: []string{"XYZ+1'", "STS+1+I'", "LOC+175+SESTO'", "DTM+334:20300101:102'"},
+			records:  1,
+			unmapped: []int{4},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			records, unmapped, err := ReadIFTSTA(strings.NewReader(iftsta(tt.segments...)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(records) != tt.records {
+				t.Errorf("got records %+v, want %d", records, tt.records)
+			}
+			if len(unmapped) != len(tt.unmapped) {
+				t.Fatalf("got unmapped segments %+v, want %v", unmapped, tt.unmapped)
+			}
+			for i, u := range unmapped {
+				if u.Segment != tt.unmapped[i] || u.Err == "" {
+					t.Errorf("unmapped %d: got %+v, want segment %d", i, u, tt.unmapped[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReadIFTSTAUnsupportedMessage(t *testing.T) {
+	in := "UNB+UNOC:3+SENDER+RECEIVER+300101:1200+REF1'" +
+		"UNH+1+IFTMIN:D:01B:UN'CNI+1+ABC123'STS+1+I'UNT+4+1'" +
+		"UNH+2+IFTSTA:D:01B:UN'CNI+1+ABC123'STS+1+I'LOC+175+SESTO'DTM+334:20300101:102'UNT+6+2'" +
+		"UNZ+2+REF1'"
+	records, unmapped, err := ReadIFTSTA(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 || records[0].Row != 8 {
+		t.Errorf("got records %+v, want the status of the IFTSTA message", records)
+	}
+	if len(unmapped) != 1 || unmapped[0].Segment != 2 {
+		t.Errorf("got unmapped segments %+v, want the IFTMIN header", unmapped)
+	}
+}
@@ -0,0 +1,116 @@
+package handling
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+// Record is a handling event read from an import file.
+type Record struct {
+	Row            int
+	TrackingID     cargo.TrackingID
+	VoyageNumber   voyage.Number
+	Location       location.UNLcode
+	EventType      cargo.HandlingEventType
+	CompletionTime time.Time
+	EventID        string
+}
+
+// RowError describes why a row of an import file was rejected.
+type RowError struct {
+	Row int    `json:"row"`
+	Err string `json:"error"`
+}
+
+// ImportReport summarizes the outcome of an import.
+type ImportReport struct {
+	Total    int        `json:"total"`
+	Accepted int        `json:"accepted"`
+	Failed   []RowError `json:"failed"`
+}
+
+func (r *ImportReport) reject(errs ...RowError) {
+	r.Total += len(errs)
+	r.Failed = append(r.Failed, errs...)
+	sort.SliceStable(r.Failed, func(i, j int) bool { return r.Failed[i].Row < r.Failed[j].Row })
+}
+
+// ReadCSV reads handling events from CSV with the columns tracking id,
+// voyage, location, event type and completion time (RFC 3339), optionally
+// followed by an event ID. A leading header row is skipped. Rows that cannot
+// be parsed are returned as row errors. Rows are numbered by their position
+// in the file, starting at 1 and counting any header.
+func ReadCSV(r io.Reader) ([]Record, []RowError, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var (
+		records []Record
+		errs    []RowError
+	)
+	for row := 1; ; row++ {
+		fields, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if _, ok := err.(*csv.ParseError); ok {
+				errs = append(errs, RowError{Row: row, Err: err.Error()})
+				continue
+			}
+			return nil, nil, err
+		}
+		if row == 1 && strings.EqualFold(strings.TrimSpace(fields[0]), "tracking_id") {
+			continue
+		}
+
+		rec, err := parseRecord(fields)
+		if err != nil {
+			errs = append(errs, RowError{Row: row, Err: err.Error()})
+			continue
+		}
+		rec.Row = row
+		records = append(records, rec)
+	}
+	return records, errs, nil
+}
+
+func parseRecord(fields []string) (Record, error) {
+	if len(fields) != 5 && len(fields) != 6 {
+		return Record{}, fmt.Errorf("expected 5 or 6 fields, got %d", len(fields))
+	}
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	eventType := stringToEventType(fields[3])
+	if eventType == cargo.NotHandled {
+		return Record{}, fmt.Errorf("unknown event type %q", fields[3])
+	}
+
+	completed, err := time.Parse(time.RFC3339, fields[4])
+	if err != nil {
+		return Record{}, fmt.Errorf("invalid completion time %q", fields[4])
+	}
+
+	rec := Record{
+		TrackingID:     cargo.TrackingID(fields[0]),
+		VoyageNumber:   voyage.Number(fields[1]),
+		Location:       location.UNLcode(fields[2]),
+		EventType:      eventType,
+		CompletionTime: completed,
+	}
+	if len(fields) == 6 {
+		rec.EventID = fields[5]
+	}
+	return rec, nil
+}
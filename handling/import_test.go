@@ -0,0 +1,141 @@
+package handling
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/inmem"
+	"github.com/Qalifah/shipping/location"
+)
+
+type recordingHandler struct {
+	events []cargo.HandlingEvent
+}
+
+func (h *recordingHandler) CargoWasHandled(e cargo.HandlingEvent) {
+	h.events = append(h.events, e)
+}
+
+func newTestService(t *testing.T, ids ...cargo.TrackingID) (Service, *recordingHandler) {
+	t.Helper()
+	cargos := inmem.NewCargoRepository()
+	for _, id := range ids {
+		if err := cargos.Store(cargo.New(id, cargo.RouteSpecification{Origin: location.SESTO, Destination: location.USNYC})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h := &recordingHandler{}
+	s := NewService(inmem.NewHandlingEventRepository(), cargo.HandlingEventFactory{
+		CargoRepository:    cargos,
+		VoyageRepository:   inmem.NewVoyageRepository(),
+		LocationRepository: inmem.NewLocationRepository(),
+	}, h)
+	return s, h
+}
+
+func TestReadCSV(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		in      string
+		records []Record
+		errs    []int
+	}{
+		{
+			name: "header row",
+			in: "tracking_id,voyage,location,event_type,completion_time\n" +
+				"ABC123,,SESTO,Receive,2030-01-01T00:00:00Z\n",
+			records: []Record{{Row: 2, TrackingID: "ABC123", Location: location.SESTO, EventType: cargo.Receive, CompletionTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		},
+		{
+			name: "no header row",
+			in:   "ABC123, V100, SESTO, Load, 2030-01-01T02:00:00+02:00, E1\n",
+			records: []Record{{Row: 1, TrackingID: "ABC123", VoyageNumber: "V100", Location: location.SESTO, EventType: cargo.Load, CompletionTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), EventID: "E1"}},
+		},
+		{
+			name: "bad completion time",
+			in:   "ABC123,,SESTO,Receive,yesterday\n",
+			errs: []int{1},
+		},
+		{
+			name: "unknown event type",
+			in:   "ABC123,,SESTO,Teleport,2030-01-01T00:00:00Z\n",
+			errs: []int{1},
+		},
+		{
+			name: "wrong number of fields",
+			in:   "ABC123,SESTO,Receive\n",
+			errs: []int{1},
+		},
+		{
+			name: "valid rows around invalid ones",
+			in: "tracking_id,voyage,location,event_type,completion_time,event_id\n" +
+				"ABC123,,SESTO,Receive,2030-01-01T00:00:00Z,E1\n" +
+				"ABC123,,SESTO,Receive,not a time,E2\n" +
+				"ABC123,V100,SESTO,Load,2030-01-02T00:00:00Z,E3\n" +
+				"ABC123,V100,USNYC,Dance,2030-01-05T00:00:00Z,E4\n",
+			records: []Record{
+				{Row: 2, TrackingID: "ABC123", Location: location.SESTO, EventType: cargo.Receive, CompletionTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), EventID: "E1"},
+				{Row: 4, TrackingID: "ABC123", VoyageNumber: "V100", Location: location.SESTO, EventType: cargo.Load, CompletionTime: time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC), EventID: "E3"},
+			},
+			errs: []int{3, 5},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			records, errs, err := ReadCSV(strings.NewReader(tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(records) != len(tt.records) {
+				t.Fatalf("got records %+v, want %+v", records, tt.records)
+			}
+			for i, r := range records {
+				want := tt.records[i]
+				if !r.CompletionTime.Equal(want.CompletionTime) {
+					t.Errorf("record %d: got completion time %v, want %v", i, r.CompletionTime, want.CompletionTime)
+				}
+				r.CompletionTime, want.CompletionTime = time.Time{}, time.Time{}
+				if r != want {
+					t.Errorf("record %d: got %+v, want %+v", i, r, want)
+				}
+			}
+			if len(errs) != len(tt.errs) {
+				t.Fatalf("got row errors %+v, want rows %v", errs, tt.errs)
+			}
+			for i, e := range errs {
+				if e.Row != tt.errs[i] || e.Err == "" {
+					t.Errorf("row error %d: got %+v, want row %d", i, e, tt.errs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestImportHandlingEventsDeduplicatesByEventID(t *testing.T) {
+	s, h := newTestService(t, "ABC123")
+
+	records, errs, err := ReadCSV(strings.NewReader(
+		"ABC123,,SESTO,Receive,2030-01-01T00:00:00Z,E1\n" +
+			"ABC123,V100,SESTO,Load,2030-01-02T00:00:00Z,E2\n" +
+			// A resent row, registered only once.
+			"ABC123,,SESTO,Receive,2030-01-01T00:00:00Z,E1\n" +
+			// The same event ID for a different event.
+			"ABC123,V100,USNYC,Unload,2030-01-10T00:00:00Z,E2\n" +
+			"NOPE,,SESTO,Receive,2030-01-01T00:00:00Z,E5\n",
+	))
+	if err != nil || len(errs) != 0 {
+		t.Fatalf("reading: %v %+v", err, errs)
+	}
+
+	report := s.ImportHandlingEvents(records)
+	if report.Total != 5 || report.Accepted != 3 {
+		t.Errorf("got %d of %d accepted, want 3 of 5", report.Accepted, report.Total)
+	}
+	if len(report.Failed) != 2 || report.Failed[0].Row != 4 || report.Failed[0].Err != ErrEventIDReused.Error() || report.Failed[1].Row != 5 {
+		t.Errorf("got failed rows %+v, want rows 4 and 5", report.Failed)
+	}
+	if len(h.events) != 2 {
+		t.Errorf("handled %d events, want 2", len(h.events))
+	}
+}
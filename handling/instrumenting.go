@@ -25,12 +25,21 @@ func NewInstrumentingService(counter metrics.Counter, latency metrics.Histogram,
 	}
 }
 
-func (s *instrumentingService) RegisterHandlingEvent(completed time.Time, id cargo.TrackingID, voyageNumber voyage.Number, loc location.UNLcode, eventType cargo.HandlingEventType) error {
+func (s *instrumentingService) RegisterHandlingEvent(completed time.Time, id cargo.TrackingID, voyageNumber voyage.Number, loc location.UNLcode, eventType cargo.HandlingEventType, eventID string) error {
 
 	defer func(begin time.Time) {
 		s.requestCount.With("method", "register_incident").Add(1)
 		s.requestLatency.With("method", "register_incident").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return s.Service.RegisterHandlingEvent(completed, id, voyageNumber, loc, eventType)
+	return s.Service.RegisterHandlingEvent(completed, id, voyageNumber, loc, eventType, eventID)
+}
+
+func (s *instrumentingService) ImportHandlingEvents(records []Record) ImportReport {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "import_handling_events").Add(1)
+		s.requestLatency.With("method", "import_handling_events").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.ImportHandlingEvents(records)
 }
\ No newline at end of file
@@ -20,7 +20,7 @@ func NewLoggingService(logger log.Logger, s Service) Service {
 	return &loggingService{logger, s}
 }
 
-func (s *loggingService) RegisterHandlingEvent(completed time.Time, id cargo.TrackingID, voyageNumber voyage.Number, unLcode location.UNLcode, eventType cargo.HandlingEventType) (err error) {
+func (s *loggingService) RegisterHandlingEvent(completed time.Time, id cargo.TrackingID, voyageNumber voyage.Number, unLcode location.UNLcode, eventType cargo.HandlingEventType, eventID string) (err error) {
 	defer func(begin time.Time) {
 		s.logger.Log(
 			"method", "register_incident",
@@ -29,9 +29,23 @@ func (s *loggingService) RegisterHandlingEvent(completed time.Time, id cargo.Tra
 			"voyage", voyageNumber,
 			"event_type", eventType,
 			"completion_time", completed,
+			"event_id", eventID,
 			"took", time.Since(begin),
 			"err", err,
 		)
 	}(time.Now())
-	return s.Service.RegisterHandlingEvent(completed, id, voyageNumber, unLcode, eventType)
+	return s.Service.RegisterHandlingEvent(completed, id, voyageNumber, unLcode, eventType, eventID)
+}
+
+func (s *loggingService) ImportHandlingEvents(records []Record) (report ImportReport) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "import_handling_events",
+			"total", report.Total,
+			"accepted", report.Accepted,
+			"failed", len(report.Failed),
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	return s.Service.ImportHandlingEvents(records)
 }
\ No newline at end of file
@@ -2,6 +2,8 @@ package handling
 
 import (
 	"errors"
+	"math"
+	"sync"
 	"time"
 
 	"github.com/Qalifah/shipping/cargo"
@@ -13,43 +15,178 @@ import (
 // ErrInvalidArgument is returned when one or more arguments are invalid
 var ErrInvalidArgument = errors.New("invalid argument")
 
+// ErrEventIDReused is returned when an event ID is registered again with
+// different event details
+var ErrEventIDReused = errors.New("event id already registered for a different event")
+
+// ErrImplausibleLocation is returned when a cargo cannot have travelled
+// between the location of a handling event and those of the events completed
+// just before or after it in the time between them
+var ErrImplausibleLocation = errors.New("cargo cannot have been handled at the location at that time")
+
+// maxSpeed is the highest plausible average speed of a cargo between two
+// handling events, in kilometres per hour. It is generous enough for air
+// freight, so that only clearly wrong locations or times are rejected.
+const maxSpeed = 1000.0
+
 // EventHandler provides a means of subscribing to registered handling events
 type EventHandler interface {
 	CargoWasHandled(cargo.HandlingEvent)
 }
 
+// Publisher publishes registered handling events to in-process subscribers
+type Publisher interface {
+	Publish(cargo.HandlingEvent)
+}
+
 // Service provides handling operations
 type Service interface {
 	// RegisterHandlingEvent registers a handling event in the system, and
-	// notifies interested parties that a cargo has been handled.
-	RegisterHandlingEvent(completed time.Time, id cargo.TrackingID, voyageNumber voyage.Number, unLcode location.UNLcode, eventType cargo.HandlingEventType) error 
+	// notifies interested parties that a cargo has been handled. Registering
+	// the same event again, either with the same client-supplied event ID or
+	// with identical details, has no effect.
+	RegisterHandlingEvent(completed time.Time, id cargo.TrackingID, voyageNumber voyage.Number, unLcode location.UNLcode, eventType cargo.HandlingEventType, eventID string) error 
+
+	// ImportHandlingEvents registers each of the records in turn, and reports
+	// the ones that failed validation or registration.
+	ImportHandlingEvents(records []Record) ImportReport
 }
 
 type service struct {
+	mtx	sync.Mutex
+	locks	map[cargo.TrackingID]*cargoLock
 	handlingEventRespository	cargo.HandlingEventRepository
 	handlingEventFactory		cargo.HandlingEventFactory
 	handlingEventHandler		EventHandler
 }
 
-func(s *service) RegisterHandlingEvent(completed time.Time, id cargo.TrackingID, voyageNumber voyage.Number, unLcode location.UNLcode, eventType cargo.HandlingEventType) error {
+func(s *service) RegisterHandlingEvent(completed time.Time, id cargo.TrackingID, voyageNumber voyage.Number, unLcode location.UNLcode, eventType cargo.HandlingEventType, eventID string) error {
 	if completed.IsZero() || id == "" || unLcode == "" || eventType == cargo.NotHandled {
 		return ErrInvalidArgument
 	}
 
-	e, err := s.handlingEventFactory.CreateHandlingEvent(time.Now(), completed, id, voyageNumber, unLcode, eventType)
+	e, err := s.handlingEventFactory.CreateHandlingEvent(time.Now(), completed, id, voyageNumber, unLcode, eventType, eventID)
 	if err != nil {
 		return err
 	}
 
-	s.handlingEventRespository.Store(e)
+	stored, err := s.store(e)
+	if err != nil || !stored {
+		return err
+	}
+
+	// The handler is called without holding the cargo's lock, so that slow
+	// downstream handling does not hold up further registrations.
 	s.handlingEventHandler.CargoWasHandled(e)
 
 	return nil
 }
 
+// store stores e unless it has already been registered. Events of the same
+// cargo are checked and stored one at a time, so that duplicates cannot slip
+// in between the check and the store.
+func(s *service) store(e cargo.HandlingEvent) (bool, error) {
+	unlock := s.lock(e.TrackingID)
+	defer unlock()
+
+	history := s.handlingEventRespository.QueryHandlingHistory(e.TrackingID)
+	for _, registered := range history.HandlingEvents {
+		if e.ID != "" && registered.ID == e.ID {
+			if !registered.SameAs(e) {
+				return false, ErrEventIDReused
+			}
+			return false, nil
+		}
+		if registered.SameAs(e) {
+			return false, nil
+		}
+	}
+
+	if !s.plausible(e, history) {
+		return false, ErrImplausibleLocation
+	}
+
+	s.handlingEventRespository.Store(e)
+
+	return true, nil
+}
+
+// cargoLock serializes the registrations of a single cargo. It is removed
+// from the service once nobody holds or waits for it.
+type cargoLock struct {
+	mtx	sync.Mutex
+	refs	int
+}
+
+// lock locks the cargo and returns a function that unlocks it.
+func(s *service) lock(id cargo.TrackingID) func() {
+	s.mtx.Lock()
+	l, ok := s.locks[id]
+	if !ok {
+		l = &cargoLock{}
+		s.locks[id] = l
+	}
+	l.refs++
+	s.mtx.Unlock()
+
+	l.mtx.Lock()
+	return func() {
+		l.mtx.Unlock()
+
+		s.mtx.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(s.locks, id)
+		}
+		s.mtx.Unlock()
+	}
+}
+
+// plausible checks the implied speed of the cargo between e and the events
+// of the history completed just before and after it. Locations without
+// coordinates are not checked.
+func(s *service) plausible(e cargo.HandlingEvent, history cargo.HandlingHistory) bool {
+	var before, after *cargo.HandlingEvent
+	for i := range history.HandlingEvents {
+		if !history.HandlingEvents[i].CompletedBefore(e) {
+			after = &history.HandlingEvents[i]
+			break
+		}
+		before = &history.HandlingEvents[i]
+	}
+
+	for _, n := range []*cargo.HandlingEvent{before, after} {
+		if n == nil {
+			continue
+		}
+		d, err := location.Distance(s.handlingEventFactory.LocationRepository, n.Activity.Location, e.Activity.Location)
+		if err != nil {
+			continue
+		}
+		if d > maxSpeed*math.Abs(e.Completed.Sub(n.Completed).Hours()) {
+			return false
+		}
+	}
+	return true
+}
+
+func(s *service) ImportHandlingEvents(records []Record) ImportReport {
+	report := ImportReport{Failed: make([]RowError, 0)}
+	for _, r := range records {
+		report.Total++
+		if err := s.RegisterHandlingEvent(r.CompletionTime, r.TrackingID, r.VoyageNumber, r.Location, r.EventType, r.EventID); err != nil {
+			report.Failed = append(report.Failed, RowError{Row: r.Row, Err: err.Error()})
+			continue
+		}
+		report.Accepted++
+	}
+	return report
+}
+
 // NewService creates a handling event service with necessary dependencies.
 func NewService(r cargo.HandlingEventRepository, f cargo.HandlingEventFactory, h EventHandler) Service {
 	return &service{
+		locks: make(map[cargo.TrackingID]*cargoLock),
 		handlingEventRespository: r,
 		handlingEventFactory: f,
 		handlingEventHandler: h,
@@ -69,4 +206,23 @@ func NewEventHandler(s inspection.Service) EventHandler {
 	return &handlingEventHandler{
 		InspectionService: s,
 	}
-}
\ No newline at end of file
+}
+type publishingEventHandler struct {
+	next		EventHandler
+	publisher	Publisher
+}
+
+func(h *publishingEventHandler) CargoWasHandled(event cargo.HandlingEvent) {
+	h.next.CargoWasHandled(event)
+	h.publisher.Publish(event)
+}
+
+// NewPublishingEventHandler returns an EventHandler that passes each event on
+// to next, and then publishes it, so that subscribers find the cargo already
+// inspected.
+func NewPublishingEventHandler(next EventHandler, p Publisher) EventHandler {
+	return &publishingEventHandler{
+		next:		next,
+		publisher:	p,
+	}
+}
@@ -16,7 +16,17 @@ type cargoRepository struct {
 func (r *cargoRepository) Store(c *cargo.Cargo) error {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
-	r.cargos[c.TrackingID] = c
+	var current int
+	if val, ok := r.cargos[c.TrackingID]; ok {
+		current = val.Version
+	}
+	if current != c.Version {
+		return &cargo.ConflictError{TrackingID: c.TrackingID, Expected: c.Version, Actual: current}
+	}
+	// Only the snapshot is kept, the recorded domain events are not needed.
+	c.ClearChanges()
+	c.Version++
+	r.cargos[c.TrackingID] = c.Clone()
 	return nil
 }
 
@@ -24,7 +34,7 @@ func (r *cargoRepository) Find(id cargo.TrackingID) (*cargo.Cargo, error) {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 	if val, ok := r.cargos[id]; ok {
-		return val, nil
+		return val.Clone(), nil
 	}
 	return nil, cargo.ErrUnknown
 }
@@ -34,7 +44,7 @@ func (r *cargoRepository) FindAll() []*cargo.Cargo {
 	defer r.mtx.RUnlock()
 	c := make([]*cargo.Cargo, 0, len(r.cargos))
 	for _, val := range r.cargos {
-		c = append(c, val)
+		c = append(c, val.Clone())
 	}
 	return c
 }
@@ -47,20 +57,37 @@ func NewCargoRepository() cargo.Repository {
 }
 
 type locationRepository struct {
+	mtx       sync.RWMutex
 	locations map[location.UNLcode]*location.Location
 }
 
+func (r *locationRepository) Store(ls ...*location.Location) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for _, l := range ls {
+		val := *l
+		r.locations[l.UNLcode] = &val
+	}
+	return nil
+}
+
 func (r *locationRepository) Find(locode location.UNLcode) (*location.Location, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
 	if l, ok := r.locations[locode]; ok {
-		return l, nil
+		val := *l
+		return &val, nil
 	}
 	return nil, location.ErrUnknown
 }
 
 func (r *locationRepository) FindAll() []*location.Location {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
 	l := make([]*location.Location, 0, len(r.locations))
 	for _, val := range r.locations {
-		l = append(l, val)
+		val := *val
+		l = append(l, &val)
 	}
 	return l
 }
@@ -77,15 +104,31 @@ func NewLocationRepository() location.Repository {
 	r.locations[location.JNTKO] = location.Tokyo
 	r.locations[location.NLRTM] = location.Rotterdam
 	r.locations[location.DEHAM] = location.Hamburg
+	r.locations[location.USNYC] = location.NewYork
+	r.locations[location.USCHI] = location.Chicago
+	r.locations[location.FIHEL] = location.Helsinki
 
 	return r
 }
 
 type voyageRepository struct {
+	mtx     sync.RWMutex
 	voyages map[voyage.Number]*voyage.Voyage
 }
 
+func (r *voyageRepository) Store(v *voyage.Voyage) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	// Stored voyages are never modified, so they can be shared with readers.
+	movements := make([]voyage.CarrierMovement, len(v.Schedule.CarrierMovements))
+	copy(movements, v.Schedule.CarrierMovements)
+	r.voyages[v.Number] = voyage.New(v.Number, voyage.Schedule{CarrierMovements: movements})
+	return nil
+}
+
 func (r *voyageRepository) Find(voyageNumber voyage.Number) (*voyage.Voyage, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
 	if v, ok := r.voyages[voyageNumber]; ok {
 		return v, nil
 	}
@@ -93,6 +136,16 @@ func (r *voyageRepository) Find(voyageNumber voyage.Number) (*voyage.Voyage, err
 	return nil, voyage.ErrUnknown
 }
 
+func (r *voyageRepository) FindAll() []*voyage.Voyage {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	v := make([]*voyage.Voyage, 0, len(r.voyages))
+	for _, val := range r.voyages {
+		v = append(v, val)
+	}
+	return v
+}
+
 // NewVoyageRepository returns a new instance of a in-memory voyage repository.
 func NewVoyageRepository() voyage.Repository {
 	r := &voyageRepository{
@@ -130,7 +183,7 @@ func (r *handlingEventRepository) Store(e cargo.HandlingEvent) {
 func (r *handlingEventRepository) QueryHandlingHistory(id cargo.TrackingID) cargo.HandlingHistory {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
-	return cargo.HandlingHistory{HandlingEvents: r.events[id]}
+	return cargo.NewHandlingHistory(r.events[id])
 }
 
 // NewHandlingEventRepository returns a new instance of a in-memory handling event repository.
@@ -0,0 +1,130 @@
+package inspection
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+type logChannel struct {
+	logger log.Logger
+}
+
+// NewLogChannel returns a channel that logs notifications.
+func NewLogChannel(logger log.Logger) Channel {
+	return &logChannel{logger}
+}
+
+func (c *logChannel) Notify(n Notification) error {
+	return c.logger.Log(
+		"notification", n.Type,
+		"tracking_id", n.TrackingID,
+		"origin", n.Origin,
+		"destination", n.Destination,
+		"last_known_location", n.LastKnownLocation,
+		"eta", n.ETA,
+	)
+}
+
+type webhookChannel struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookChannel returns a channel that posts notifications as JSON to a
+// URL. Any response but a 2xx status is a failed delivery. If client is nil,
+// a client with a short timeout is used, so that a slow subscriber does not
+// hold up its later notifications for long.
+func NewWebhookChannel(url string, client *http.Client) Channel {
+	if client == nil {
+		client = &http.Client{Timeout: 5 * time.Second}
+	}
+	return &webhookChannel{url: url, client: client}
+}
+
+func (c *webhookChannel) Notify(n Notification) error {
+	b, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	resp, err := c.client.Post(c.url, "application/json; charset=utf-8", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
+
+type spoolChannel struct {
+	dir string
+}
+
+// NewSpoolChannel returns a channel that writes each notification as a JSON
+// file into a directory, for another process to pick up. Files are named so
+// that they sort in the order they were written, and only appear once
+// completely written.
+func NewSpoolChannel(dir string) (Channel, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &spoolChannel{dir: dir}, nil
+}
+
+func (c *spoolChannel) Notify(n Notification) error {
+	b, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%020d-%s-%s.json", n.Time.UnixNano(), n.TrackingID, n.Type)
+
+	tmp, err := ioutil.TempFile(c.dir, ".spool-")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(c.dir, name))
+}
+
+// MemoryChannel is a channel that keeps notifications in memory, e.g. for
+// tests.
+type MemoryChannel struct {
+	mtx           sync.Mutex
+	notifications []Notification
+}
+
+// Notify implements Channel.
+func (c *MemoryChannel) Notify(n Notification) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.notifications = append(c.notifications, n)
+	return nil
+}
+
+// Notifications returns the notifications received so far.
+func (c *MemoryChannel) Notifications() []Notification {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	result := make([]Notification, len(c.notifications))
+	copy(result, c.notifications)
+	return result
+}
@@ -1,13 +1,18 @@
 package inspection
 
 import (
+	"errors"
+	"time"
+
 	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/voyage"
 )
 
 // EventHandler provides means of subscribing to inspection events.
 type EventHandler interface {
 	CargoWasMisdirected(*cargo.Cargo)
 	CargoHasArrived(*cargo.Cargo)
+	CargoWasDelayed(*cargo.Cargo)
 }
 
 // Service provides cargo inspection operations.
@@ -16,8 +21,16 @@ type Service interface {
 	// interested parties, for example if a cargo has been misdirected, or
 	// unloaded at the final destination.
 	InspectCargo(id cargo.TrackingID)
+
+	// InspectDelayedVoyage shifts the itineraries of the cargos travelling
+	// on a voyage that was delayed from the given time on, and notifies
+	// interested parties of each cargo affected.
+	InspectDelayedVoyage(number voyage.Number, from time.Time, delay time.Duration)
 }
 
+// errNotDelayed is returned from a cargo update to leave the cargo as is.
+var errNotDelayed = errors.New("cargo not delayed")
+
 type service struct {
 	cargos	cargo.Repository
 	events		cargo.HandlingEventRepository
@@ -25,14 +38,15 @@ type service struct {
 }
 
 func (s *service) InspectCargo(id cargo.TrackingID) {
-	c, err := s.cargos.Find(id)
+	c, err := cargo.Update(s.cargos, id, func(c *cargo.Cargo) error {
+		h := s.events.QueryHandlingHistory(id)
+		c.DeriveDeliveryProgress(h)
+		return nil
+	})
 	if err != nil {
 		return
 	}
 
-	h := s.events.QueryHandlingHistory(id)
-	c.DeriveDeliveryProgress(h)
-
 	if c.Delivery.IsMisdirected {
 		s.handler.CargoWasMisdirected(c)
 	}
@@ -40,11 +54,51 @@ func (s *service) InspectCargo(id cargo.TrackingID) {
 	if c.Delivery.IsUnloadedAtDestination {
 		s.handler.CargoHasArrived(c)
 	}
+}
+
+func (s *service) InspectDelayedVoyage(number voyage.Number, from time.Time, delay time.Duration) {
+	for _, c := range s.cargos.FindAll() {
+		if !c.Itinerary.UsesVoyage(number) || c.Delivery.TransportStatus == cargo.Claimed {
+			continue
+		}
 
-	s.cargos.Store(c)
+		c, err := cargo.Update(s.cargos, c.TrackingID, func(c *cargo.Cargo) error {
+			delayed := c.Itinerary.Delay(number, from, delay)
+			if sameTimes(delayed, c.Itinerary) {
+				// The cargo is already past the delayed part of the voyage.
+				return errNotDelayed
+			}
+			c.AssignToRoute(delayed)
+			return nil
+		})
+		if err != nil {
+			continue
+		}
+
+		s.handler.CargoWasDelayed(c)
+	}
 }
 
-// NewService creates a inspection service with necessary dependencies
+func sameTimes(a, b cargo.Itinerary) bool {
+	for i := range a.Legs {
+		if !a.Legs[i].LoadTime.Equal(b.Legs[i].LoadTime) || !a.Legs[i].UnLoadTime.Equal(b.Legs[i].UnLoadTime) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewService creates a inspection service with necessary dependencies. If
+// handler is nil, no notifications are sent.
 func NewService(cargos cargo.Repository, events cargo.HandlingEventRepository, handler EventHandler) Service {
+	if handler == nil {
+		handler = nopEventHandler{}
+	}
 	return &service{cargos, events, handler}
-}
\ No newline at end of file
+}
+
+type nopEventHandler struct{}
+
+func (nopEventHandler) CargoWasMisdirected(*cargo.Cargo) {}
+func (nopEventHandler) CargoHasArrived(*cargo.Cargo)     {}
+func (nopEventHandler) CargoWasDelayed(*cargo.Cargo)     {}
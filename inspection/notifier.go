@@ -0,0 +1,154 @@
+package inspection
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/Qalifah/shipping/cargo"
+)
+
+// NotificationType describes what an inspection found out about a cargo.
+type NotificationType string
+
+// valid notification types
+const (
+	CargoMisdirected NotificationType = "cargo_misdirected"
+	CargoArrived     NotificationType = "cargo_arrived"
+	CargoDelayed     NotificationType = "cargo_delayed"
+)
+
+// Notification is sent to the channels of a Notifier when an inspection
+// finds something that interested parties should know about.
+type Notification struct {
+	Type              NotificationType `json:"type"`
+	TrackingID        string           `json:"tracking_id"`
+	Origin            string           `json:"origin"`
+	Destination       string           `json:"destination"`
+	LastKnownLocation string           `json:"last_known_location,omitempty"`
+	ETA               time.Time        `json:"eta"`
+	ArrivalDeadline   time.Time        `json:"arrival_deadline"`
+	Time              time.Time        `json:"time"`
+}
+
+func newNotification(t NotificationType, c *cargo.Cargo) Notification {
+	return Notification{
+		Type:              t,
+		TrackingID:        string(c.TrackingID),
+		Origin:            string(c.Origin),
+		Destination:       string(c.RouteSpecification.Destination),
+		LastKnownLocation: string(c.Delivery.LastKnownLocation),
+		ETA:               c.Delivery.ETA,
+		ArrivalDeadline:   c.RouteSpecification.Deadline,
+		Time:              time.Now(),
+	}
+}
+
+// Channel delivers notifications to one subscriber.
+type Channel interface {
+	Notify(Notification) error
+}
+
+// queueSize is the number of notifications a channel may fall behind by
+// before further notifications to it are dropped.
+const queueSize = 100
+
+type namedChannel struct {
+	name    string
+	channel Channel
+	queue   chan Notification
+}
+
+// Notifier is an EventHandler that fans each inspection event out to the
+// registered channels. Each channel is delivered to by its own worker, in
+// the order the events were raised, so that the inspection that raised an
+// event never waits for a channel. A channel that fails or panics is logged
+// and skipped, and one that falls too far behind misses notifications, so
+// that a bad subscriber affects neither the other channels nor inspections.
+type Notifier struct {
+	mtx      sync.RWMutex
+	logger   log.Logger
+	channels []namedChannel
+}
+
+// NewNotifier returns a Notifier without any channels, which logs the
+// failures of the channels registered later.
+func NewNotifier(logger log.Logger) *Notifier {
+	return &Notifier{logger: logger}
+}
+
+// Register adds a channel under a name used to identify it in the logs, and
+// starts delivering to it.
+func (n *Notifier) Register(name string, c Channel) {
+	nc := namedChannel{name: name, channel: c, queue: make(chan Notification, queueSize)}
+	go n.work(nc)
+
+	n.mtx.Lock()
+	defer n.mtx.Unlock()
+	n.channels = append(n.channels, nc)
+}
+
+// CargoWasMisdirected implements EventHandler.
+func (n *Notifier) CargoWasMisdirected(c *cargo.Cargo) {
+	n.notify(newNotification(CargoMisdirected, c))
+}
+
+// CargoHasArrived implements EventHandler.
+func (n *Notifier) CargoHasArrived(c *cargo.Cargo) {
+	n.notify(newNotification(CargoArrived, c))
+}
+
+// CargoWasDelayed implements EventHandler.
+func (n *Notifier) CargoWasDelayed(c *cargo.Cargo) {
+	n.notify(newNotification(CargoDelayed, c))
+}
+
+func (n *Notifier) notify(note Notification) {
+	n.mtx.RLock()
+	channels := make([]namedChannel, len(n.channels))
+	copy(channels, n.channels)
+	n.mtx.RUnlock()
+
+	for _, c := range channels {
+		select {
+		case c.queue <- note:
+		default:
+			n.log(c, note, errQueueFull)
+		}
+	}
+}
+
+// errQueueFull is logged for the notifications dropped because a channel
+// fell too far behind.
+var errQueueFull = errors.New("channel queue full, notification dropped")
+
+// work delivers the queued notifications to a channel.
+func (n *Notifier) work(c namedChannel) {
+	for note := range c.queue {
+		if err := deliver(c.channel, note); err != nil {
+			n.log(c, note, err)
+		}
+	}
+}
+
+func (n *Notifier) log(c namedChannel, note Notification, err error) {
+	n.logger.Log(
+		"channel", c.name,
+		"notification", note.Type,
+		"tracking_id", note.TrackingID,
+		"err", err,
+	)
+}
+
+// deliver turns a panic of the channel into an error.
+func deliver(c Channel, note Notification) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("channel panicked: %v", r)
+		}
+	}()
+	return c.Notify(note)
+}
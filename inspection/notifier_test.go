@@ -0,0 +1,186 @@
+package inspection
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+)
+
+var testCargo = cargo.New("ABC123", cargo.RouteSpecification{
+	Origin:      location.SESTO,
+	Destination: location.CNHKG,
+	Deadline:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+})
+
+// recordingLogger keeps the errors logged for each channel.
+type recordingLogger struct {
+	mtx  sync.Mutex
+	errs map[string][]error
+}
+
+func (l *recordingLogger) Log(keyvals ...interface{}) error {
+	var (
+		name string
+		err  error
+	)
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		switch keyvals[i] {
+		case "channel":
+			name, _ = keyvals[i+1].(string)
+		case "err":
+			err, _ = keyvals[i+1].(error)
+		}
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.errs == nil {
+		l.errs = make(map[string][]error)
+	}
+	l.errs[name] = append(l.errs[name], err)
+	return nil
+}
+
+func (l *recordingLogger) errors(name string) []error {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return append([]error(nil), l.errs[name]...)
+}
+
+type channelFunc func(Notification) error
+
+func (f channelFunc) Notify(n Notification) error { return f(n) }
+
+// waitFor polls until cond holds, failing the test after a second.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestNotifierFansOut(t *testing.T) {
+	n := NewNotifier(log.NewNopLogger())
+
+	var channels []*MemoryChannel
+	for _, name := range []string{"a", "b", "c"} {
+		c := &MemoryChannel{}
+		n.Register(name, c)
+		channels = append(channels, c)
+	}
+
+	n.CargoWasMisdirected(testCargo)
+	n.CargoHasArrived(testCargo)
+	n.CargoWasDelayed(testCargo)
+
+	want := []NotificationType{CargoMisdirected, CargoArrived, CargoDelayed}
+	for i, c := range channels {
+		waitFor(t, "notifications", func() bool { return len(c.Notifications()) == len(want) })
+		for j, note := range c.Notifications() {
+			if note.Type != want[j] || note.TrackingID != "ABC123" || note.Destination != "CNHKG" {
+				t.Errorf("channel %d, notification %d: got %+v, want %s for ABC123", i, j, note, want[j])
+			}
+		}
+	}
+}
+
+func TestNotifierIsolatesFailingChannels(t *testing.T) {
+	logger := &recordingLogger{}
+	n := NewNotifier(logger)
+
+	errBroken := errors.New("broken")
+	n.Register("erroring", channelFunc(func(Notification) error { return errBroken }))
+	n.Register("panicking", channelFunc(func(Notification) error { panic("boom") }))
+	c := &MemoryChannel{}
+	n.Register("memory", c)
+
+	n.CargoWasMisdirected(testCargo)
+	n.CargoHasArrived(testCargo)
+
+	waitFor(t, "notifications", func() bool { return len(c.Notifications()) == 2 })
+	waitFor(t, "failures", func() bool {
+		return len(logger.errors("erroring")) == 2 && len(logger.errors("panicking")) == 2
+	})
+
+	if err := logger.errors("erroring")[0]; err != errBroken {
+		t.Errorf("got %v, want %v", err, errBroken)
+	}
+	if err := logger.errors("panicking")[0]; err == nil || err.Error() != "channel panicked: boom" {
+		t.Errorf("got %v, want the panic as an error", err)
+	}
+	if errs := logger.errors("memory"); len(errs) != 0 {
+		t.Errorf("got %v logged for the memory channel, want nothing", errs)
+	}
+}
+
+func TestNotifierDropsWhenQueueFull(t *testing.T) {
+	logger := &recordingLogger{}
+	n := NewNotifier(logger)
+
+	release := make(chan struct{})
+	defer close(release)
+
+	started := make(chan struct{}, 1)
+	n.Register("stuck", channelFunc(func(Notification) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		return nil
+	}))
+	c := &MemoryChannel{}
+	n.Register("memory", c)
+
+	// The worker holds the first notification, so the queue then fills up.
+	n.CargoWasMisdirected(testCargo)
+	<-started
+	waitFor(t, "notifications", func() bool { return len(c.Notifications()) == 1 })
+
+	// Fill the queue of the stuck channel, letting the other one catch up
+	// before notifications to the stuck one start being dropped.
+	for i := 0; i < queueSize; i++ {
+		n.CargoWasMisdirected(testCargo)
+	}
+	waitFor(t, "notifications", func() bool { return len(c.Notifications()) == queueSize+1 })
+
+	const dropped = 5
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < dropped; i++ {
+			n.CargoWasMisdirected(testCargo)
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("CargoWasMisdirected blocked on a full queue")
+	}
+
+	errs := logger.errors("stuck")
+	if len(errs) != dropped {
+		t.Errorf("got %d notifications dropped, want %d", len(errs), dropped)
+	}
+	for _, err := range errs {
+		if err != errQueueFull {
+			t.Errorf("got %v, want %v", err, errQueueFull)
+		}
+	}
+
+	// The other channel has missed nothing.
+	waitFor(t, "notifications", func() bool { return len(c.Notifications()) == queueSize+dropped+1 })
+	if errs := logger.errors("memory"); len(errs) != 0 {
+		t.Errorf("got %v logged for the memory channel, want nothing", errs)
+	}
+}
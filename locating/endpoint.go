@@ -0,0 +1,282 @@
+package locating
+
+import (
+	"context"
+
+	"golang.org/x/time/rate"
+
+	"github.com/go-kit/kit/circuitbreaker"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/ratelimit"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+
+	"github.com/Qalifah/shipping/location"
+
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/sony/gobreaker"
+)
+
+type createLocationRequest struct {
+	Location location.Location
+}
+
+type createLocationResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r createLocationResponse) error() error { return r.Err }
+
+func makeCreateLocationEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createLocationRequest)
+		err := s.CreateLocation(req.Location)
+		return createLocationResponse{Err: err}, nil
+	}
+}
+
+type updateLocationRequest struct {
+	Location location.Location
+}
+
+type updateLocationResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r updateLocationResponse) error() error { return r.Err }
+
+func makeUpdateLocationEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateLocationRequest)
+		err := s.UpdateLocation(req.Location)
+		return updateLocationResponse{Err: err}, nil
+	}
+}
+
+type loadLocationRequest struct {
+	UNLocode location.UNLcode
+}
+
+type loadLocationResponse struct {
+	Location *Location `json:"location,omitempty"`
+	Err      error     `json:"error,omitempty"`
+}
+
+func (r loadLocationResponse) error() error { return r.Err }
+
+func makeLoadLocationEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(loadLocationRequest)
+		l, err := s.LoadLocation(req.UNLocode)
+		return loadLocationResponse{Location: &l, Err: err}, nil
+	}
+}
+
+type listLocationsRequest struct {
+	Country string
+}
+
+type listLocationsResponse struct {
+	Locations []Location `json:"locations,omitempty"`
+	Err       error      `json:"error,omitempty"`
+}
+
+func (r listLocationsResponse) error() error { return r.Err }
+
+func makeListLocationsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listLocationsRequest)
+		return listLocationsResponse{Locations: s.Locations(req.Country), Err: nil}, nil
+	}
+}
+
+type importLocationsRequest struct {
+	Locations []*location.Location
+}
+
+type importLocationsResponse struct {
+	Imported int   `json:"imported"`
+	Err      error `json:"error,omitempty"`
+}
+
+func (r importLocationsResponse) error() error { return r.Err }
+
+func makeImportLocationsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(importLocationsRequest)
+		n, err := s.ImportLocations(req.Locations)
+		return importLocationsResponse{Imported: n, Err: err}, nil
+	}
+}
+
+type distanceRequest struct {
+	From location.UNLcode
+	To   location.UNLcode
+}
+
+type distanceResponse struct {
+	Kilometres float64 `json:"km"`
+	Err        error   `json:"error,omitempty"`
+}
+
+func (r distanceResponse) error() error { return r.Err }
+
+func makeDistanceEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(distanceRequest)
+		km, err := s.Distance(req.From, req.To)
+		return distanceResponse{Kilometres: km, Err: err}, nil
+	}
+}
+
+// Set collects all of the endpoints that compose a locating service.
+type Set struct {
+	CreateLocationEndpoint  endpoint.Endpoint
+	UpdateLocationEndpoint  endpoint.Endpoint
+	LoadLocationEndpoint    endpoint.Endpoint
+	ListLocationsEndpoint   endpoint.Endpoint
+	ImportLocationsEndpoint endpoint.Endpoint
+	DistanceEndpoint        endpoint.Endpoint
+}
+
+// NewSet returns a Set that wraps the provided server, and wires in all of the
+// expected endpoint middlewares via the various parameters.
+func NewSet(svc Service, logger log.Logger, duration metrics.Histogram, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) Set {
+	var createLocationEndpoint endpoint.Endpoint
+	{
+		createLocationEndpoint = makeCreateLocationEndpoint(svc)
+		createLocationEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(createLocationEndpoint)
+		createLocationEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(createLocationEndpoint)
+		createLocationEndpoint = opentracing.TraceServer(otTracer, "CreateLocation")(createLocationEndpoint)
+		if zipkinTracer != nil {
+			createLocationEndpoint = zipkin.TraceEndpoint(zipkinTracer, "CreateLocation")(createLocationEndpoint)
+		}
+	}
+
+	var updateLocationEndpoint endpoint.Endpoint
+	{
+		updateLocationEndpoint = makeUpdateLocationEndpoint(svc)
+		updateLocationEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(updateLocationEndpoint)
+		updateLocationEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(updateLocationEndpoint)
+		updateLocationEndpoint = opentracing.TraceServer(otTracer, "UpdateLocation")(updateLocationEndpoint)
+		if zipkinTracer != nil {
+			updateLocationEndpoint = zipkin.TraceEndpoint(zipkinTracer, "UpdateLocation")(updateLocationEndpoint)
+		}
+	}
+
+	var loadLocationEndpoint endpoint.Endpoint
+	{
+		loadLocationEndpoint = makeLoadLocationEndpoint(svc)
+		loadLocationEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(loadLocationEndpoint)
+		loadLocationEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(loadLocationEndpoint)
+		loadLocationEndpoint = opentracing.TraceServer(otTracer, "LoadLocation")(loadLocationEndpoint)
+		if zipkinTracer != nil {
+			loadLocationEndpoint = zipkin.TraceEndpoint(zipkinTracer, "LoadLocation")(loadLocationEndpoint)
+		}
+	}
+
+	var listLocationsEndpoint endpoint.Endpoint
+	{
+		listLocationsEndpoint = makeListLocationsEndpoint(svc)
+		listLocationsEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(listLocationsEndpoint)
+		listLocationsEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(listLocationsEndpoint)
+		listLocationsEndpoint = opentracing.TraceServer(otTracer, "Locations")(listLocationsEndpoint)
+		if zipkinTracer != nil {
+			listLocationsEndpoint = zipkin.TraceEndpoint(zipkinTracer, "Locations")(listLocationsEndpoint)
+		}
+	}
+
+	var importLocationsEndpoint endpoint.Endpoint
+	{
+		importLocationsEndpoint = makeImportLocationsEndpoint(svc)
+		importLocationsEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(importLocationsEndpoint)
+		importLocationsEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(importLocationsEndpoint)
+		importLocationsEndpoint = opentracing.TraceServer(otTracer, "ImportLocations")(importLocationsEndpoint)
+		if zipkinTracer != nil {
+			importLocationsEndpoint = zipkin.TraceEndpoint(zipkinTracer, "ImportLocations")(importLocationsEndpoint)
+		}
+	}
+
+	var distanceEndpoint endpoint.Endpoint
+	{
+		distanceEndpoint = makeDistanceEndpoint(svc)
+		distanceEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(distanceEndpoint)
+		distanceEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(distanceEndpoint)
+		distanceEndpoint = opentracing.TraceServer(otTracer, "Distance")(distanceEndpoint)
+		if zipkinTracer != nil {
+			distanceEndpoint = zipkin.TraceEndpoint(zipkinTracer, "Distance")(distanceEndpoint)
+		}
+	}
+
+	return Set{
+		CreateLocationEndpoint:  createLocationEndpoint,
+		UpdateLocationEndpoint:  updateLocationEndpoint,
+		LoadLocationEndpoint:    loadLocationEndpoint,
+		ListLocationsEndpoint:   listLocationsEndpoint,
+		ImportLocationsEndpoint: importLocationsEndpoint,
+		DistanceEndpoint:        distanceEndpoint,
+	}
+}
+
+// CreateLocation implements the service interface so Set can be used as a service
+func (s Set) CreateLocation(l location.Location) error {
+	resp, err := s.CreateLocationEndpoint(context.Background(), createLocationRequest{Location: l})
+	if err != nil {
+		return err
+	}
+	return resp.(createLocationResponse).Err
+}
+
+// UpdateLocation implements the service interface so Set can be used as a service
+func (s Set) UpdateLocation(l location.Location) error {
+	resp, err := s.UpdateLocationEndpoint(context.Background(), updateLocationRequest{Location: l})
+	if err != nil {
+		return err
+	}
+	return resp.(updateLocationResponse).Err
+}
+
+// LoadLocation implements the service interface so Set can be used as a service
+func (s Set) LoadLocation(code location.UNLcode) (Location, error) {
+	resp, err := s.LoadLocationEndpoint(context.Background(), loadLocationRequest{UNLocode: code})
+	if err != nil {
+		return Location{}, err
+	}
+	response := resp.(loadLocationResponse)
+	if response.Location == nil {
+		return Location{}, response.Err
+	}
+	return *response.Location, response.Err
+}
+
+// Locations implements the service interface so Set can be used as a service
+func (s Set) Locations(country string) []Location {
+	resp, err := s.ListLocationsEndpoint(context.Background(), listLocationsRequest{Country: country})
+	if err != nil {
+		return []Location{}
+	}
+	return resp.(listLocationsResponse).Locations
+}
+
+// ImportLocations implements the service interface so Set can be used as a service
+func (s Set) ImportLocations(locations []*location.Location) (int, error) {
+	resp, err := s.ImportLocationsEndpoint(context.Background(), importLocationsRequest{Locations: locations})
+	if err != nil {
+		return 0, err
+	}
+	response := resp.(importLocationsResponse)
+	return response.Imported, response.Err
+}
+
+// Distance implements the service interface so Set can be used as a service
+func (s Set) Distance(from, to location.UNLcode) (float64, error) {
+	resp, err := s.DistanceEndpoint(context.Background(), distanceRequest{From: from, To: to})
+	if err != nil {
+		return 0, err
+	}
+	response := resp.(distanceResponse)
+	return response.Kilometres, response.Err
+}
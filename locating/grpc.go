@@ -0,0 +1,468 @@
+package locating
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/Qalifah/shipping/location"
+	pb "github.com/Qalifah/shipping/pb/locatingpb"
+
+	"github.com/go-kit/kit/circuitbreaker"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/ratelimit"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/sony/gobreaker"
+	"golang.org/x/time/rate"
+)
+
+type grpcServer struct {
+	createLocation  grpctransport.Handler
+	updateLocation  grpctransport.Handler
+	loadLocation    grpctransport.Handler
+	listLocations   grpctransport.Handler
+	importLocations grpctransport.Handler
+	distance        grpctransport.Handler
+}
+
+// NewGRPCServer makes a set of endpoints available on a grpc server
+func NewGRPCServer(endpoints Set, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer, logger log.Logger) pb.LocatingServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.GRPCServerTrace(zipkinTracer))
+	}
+
+	return &grpcServer{
+		createLocation: grpctransport.NewServer(
+			endpoints.CreateLocationEndpoint,
+			decodeGRPCCreateLocationRequest,
+			encodeGRPCCreateLocationResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "createLocation", logger)))...,
+		),
+		updateLocation: grpctransport.NewServer(
+			endpoints.UpdateLocationEndpoint,
+			decodeGRPCUpdateLocationRequest,
+			encodeGRPCUpdateLocationResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "updateLocation", logger)))...,
+		),
+		loadLocation: grpctransport.NewServer(
+			endpoints.LoadLocationEndpoint,
+			decodeGRPCLoadLocationRequest,
+			encodeGRPCLoadLocationResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "loadLocation", logger)))...,
+		),
+		listLocations: grpctransport.NewServer(
+			endpoints.ListLocationsEndpoint,
+			decodeGRPCListLocationsRequest,
+			encodeGRPCListLocationsResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "listLocations", logger)))...,
+		),
+		importLocations: grpctransport.NewServer(
+			endpoints.ImportLocationsEndpoint,
+			decodeGRPCImportLocationsRequest,
+			encodeGRPCImportLocationsResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "importLocations", logger)))...,
+		),
+		distance: grpctransport.NewServer(
+			endpoints.DistanceEndpoint,
+			decodeGRPCDistanceRequest,
+			encodeGRPCDistanceResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "distance", logger)))...,
+		),
+	}
+}
+
+func (s *grpcServer) CreateLocation(ctx context.Context, req *pb.CreateLocationRequest) (*pb.CreateLocationReply, error) {
+	_, rep, err := s.createLocation.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.CreateLocationReply), nil
+}
+
+func (s *grpcServer) UpdateLocation(ctx context.Context, req *pb.UpdateLocationRequest) (*pb.UpdateLocationReply, error) {
+	_, rep, err := s.updateLocation.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.UpdateLocationReply), nil
+}
+
+func (s *grpcServer) LoadLocation(ctx context.Context, req *pb.LoadLocationRequest) (*pb.LoadLocationReply, error) {
+	_, rep, err := s.loadLocation.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.LoadLocationReply), nil
+}
+
+func (s *grpcServer) ListLocations(ctx context.Context, req *pb.ListLocationsRequest) (*pb.ListLocationsReply, error) {
+	_, rep, err := s.listLocations.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.ListLocationsReply), nil
+}
+
+func (s *grpcServer) ImportLocations(ctx context.Context, req *pb.ImportLocationsRequest) (*pb.ImportLocationsReply, error) {
+	_, rep, err := s.importLocations.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.ImportLocationsReply), nil
+}
+
+func (s *grpcServer) Distance(ctx context.Context, req *pb.DistanceRequest) (*pb.DistanceReply, error) {
+	_, rep, err := s.distance.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.DistanceReply), nil
+}
+
+// NewGRPCClient returns a locating service backed by a grpc server at the other end of the conn
+func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer, logger log.Logger) Service {
+	limiter := ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Every(time.Second), 100))
+	var options []grpctransport.ClientOption
+	if zipkinTracer != nil {
+		options = append(options, zipkin.GRPCClientTrace(zipkinTracer))
+	}
+
+	var createLocationEndpoint endpoint.Endpoint
+	{
+		createLocationEndpoint = grpctransport.NewClient(
+			conn,
+			"locatingpb.Locating",
+			"CreateLocation",
+			encodeGRPCCreateLocationRequest,
+			decodeGRPCCreateLocationResponse,
+			pb.CreateLocationReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		createLocationEndpoint = opentracing.TraceClient(otTracer, "Create Location")(createLocationEndpoint)
+		createLocationEndpoint = limiter(createLocationEndpoint)
+		createLocationEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Create Location",
+			Timeout: 30 * time.Second,
+		}))(createLocationEndpoint)
+	}
+
+	var updateLocationEndpoint endpoint.Endpoint
+	{
+		updateLocationEndpoint = grpctransport.NewClient(
+			conn,
+			"locatingpb.Locating",
+			"UpdateLocation",
+			encodeGRPCUpdateLocationRequest,
+			decodeGRPCUpdateLocationResponse,
+			pb.UpdateLocationReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		updateLocationEndpoint = opentracing.TraceClient(otTracer, "Update Location")(updateLocationEndpoint)
+		updateLocationEndpoint = limiter(updateLocationEndpoint)
+		updateLocationEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Update Location",
+			Timeout: 30 * time.Second,
+		}))(updateLocationEndpoint)
+	}
+
+	var loadLocationEndpoint endpoint.Endpoint
+	{
+		loadLocationEndpoint = grpctransport.NewClient(
+			conn,
+			"locatingpb.Locating",
+			"LoadLocation",
+			encodeGRPCLoadLocationRequest,
+			decodeGRPCLoadLocationResponse,
+			pb.LoadLocationReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		loadLocationEndpoint = opentracing.TraceClient(otTracer, "Load Location")(loadLocationEndpoint)
+		loadLocationEndpoint = limiter(loadLocationEndpoint)
+		loadLocationEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Load Location",
+			Timeout: 30 * time.Second,
+		}))(loadLocationEndpoint)
+	}
+
+	var listLocationsEndpoint endpoint.Endpoint
+	{
+		listLocationsEndpoint = grpctransport.NewClient(
+			conn,
+			"locatingpb.Locating",
+			"ListLocations",
+			encodeGRPCListLocationsRequest,
+			decodeGRPCListLocationsResponse,
+			pb.ListLocationsReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		listLocationsEndpoint = opentracing.TraceClient(otTracer, "List Locations")(listLocationsEndpoint)
+		listLocationsEndpoint = limiter(listLocationsEndpoint)
+		listLocationsEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "List Locations",
+			Timeout: 30 * time.Second,
+		}))(listLocationsEndpoint)
+	}
+
+	var importLocationsEndpoint endpoint.Endpoint
+	{
+		importLocationsEndpoint = grpctransport.NewClient(
+			conn,
+			"locatingpb.Locating",
+			"ImportLocations",
+			encodeGRPCImportLocationsRequest,
+			decodeGRPCImportLocationsResponse,
+			pb.ImportLocationsReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		importLocationsEndpoint = opentracing.TraceClient(otTracer, "Import Locations")(importLocationsEndpoint)
+		importLocationsEndpoint = limiter(importLocationsEndpoint)
+		importLocationsEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Import Locations",
+			Timeout: 30 * time.Second,
+		}))(importLocationsEndpoint)
+	}
+
+	var distanceEndpoint endpoint.Endpoint
+	{
+		distanceEndpoint = grpctransport.NewClient(
+			conn,
+			"locatingpb.Locating",
+			"Distance",
+			encodeGRPCDistanceRequest,
+			decodeGRPCDistanceResponse,
+			pb.DistanceReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		distanceEndpoint = opentracing.TraceClient(otTracer, "Distance")(distanceEndpoint)
+		distanceEndpoint = limiter(distanceEndpoint)
+		distanceEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Distance",
+			Timeout: 30 * time.Second,
+		}))(distanceEndpoint)
+	}
+
+	return Set{
+		CreateLocationEndpoint:  createLocationEndpoint,
+		UpdateLocationEndpoint:  updateLocationEndpoint,
+		LoadLocationEndpoint:    loadLocationEndpoint,
+		ListLocationsEndpoint:   listLocationsEndpoint,
+		ImportLocationsEndpoint: importLocationsEndpoint,
+		DistanceEndpoint:        distanceEndpoint,
+	}
+}
+
+func decodeGRPCCreateLocationRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CreateLocationRequest)
+	return createLocationRequest{Location: decodeLocation(req.Location)}, nil
+}
+
+func decodeGRPCUpdateLocationRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.UpdateLocationRequest)
+	return updateLocationRequest{Location: decodeLocation(req.Location)}, nil
+}
+
+func decodeGRPCLoadLocationRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.LoadLocationRequest)
+	return loadLocationRequest{UNLocode: location.UNLcode(req.Unlocode)}, nil
+}
+
+func decodeGRPCListLocationsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ListLocationsRequest)
+	return listLocationsRequest{Country: req.Country}, nil
+}
+
+func decodeGRPCImportLocationsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ImportLocationsRequest)
+	locations := make([]*location.Location, 0, len(req.Locations))
+	for _, l := range req.Locations {
+		l := decodeLocation(l)
+		locations = append(locations, &l)
+	}
+	return importLocationsRequest{Locations: locations}, nil
+}
+
+func decodeGRPCDistanceRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.DistanceRequest)
+	return distanceRequest{
+		From: location.UNLcode(req.From),
+		To:   location.UNLcode(req.To),
+	}, nil
+}
+
+func encodeGRPCCreateLocationResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(createLocationResponse)
+	return &pb.CreateLocationReply{Err: err2str(resp.Err)}, nil
+}
+
+func encodeGRPCUpdateLocationResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(updateLocationResponse)
+	return &pb.UpdateLocationReply{Err: err2str(resp.Err)}, nil
+}
+
+func encodeGRPCLoadLocationResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(loadLocationResponse)
+	reply := &pb.LoadLocationReply{Err: err2str(resp.Err)}
+	if resp.Err == nil && resp.Location != nil {
+		reply.Location = encodeLocation(toLocation(*resp.Location))
+	}
+	return reply, nil
+}
+
+func encodeGRPCListLocationsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listLocationsResponse)
+	var locations []*pb.Location
+	for _, l := range resp.Locations {
+		locations = append(locations, encodeLocation(toLocation(l)))
+	}
+	return &pb.ListLocationsReply{Locations: locations}, nil
+}
+
+func encodeGRPCImportLocationsResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(importLocationsResponse)
+	return &pb.ImportLocationsReply{Imported: int32(resp.Imported), Err: err2str(resp.Err)}, nil
+}
+
+func encodeGRPCDistanceResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(distanceResponse)
+	return &pb.DistanceReply{Km: resp.Kilometres, Err: err2str(resp.Err)}, nil
+}
+
+func encodeGRPCCreateLocationRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(createLocationRequest)
+	return &pb.CreateLocationRequest{Location: encodeLocation(req.Location)}, nil
+}
+
+func encodeGRPCUpdateLocationRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(updateLocationRequest)
+	return &pb.UpdateLocationRequest{Location: encodeLocation(req.Location)}, nil
+}
+
+func encodeGRPCLoadLocationRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(loadLocationRequest)
+	return &pb.LoadLocationRequest{Unlocode: string(req.UNLocode)}, nil
+}
+
+func encodeGRPCListLocationsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(listLocationsRequest)
+	return &pb.ListLocationsRequest{Country: req.Country}, nil
+}
+
+func encodeGRPCImportLocationsRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(importLocationsRequest)
+	locations := make([]*pb.Location, 0, len(req.Locations))
+	for _, l := range req.Locations {
+		locations = append(locations, encodeLocation(*l))
+	}
+	return &pb.ImportLocationsRequest{Locations: locations}, nil
+}
+
+func encodeGRPCDistanceRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(distanceRequest)
+	return &pb.DistanceRequest{From: string(req.From), To: string(req.To)}, nil
+}
+
+func decodeGRPCCreateLocationResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.CreateLocationReply)
+	return createLocationResponse{Err: str2err(reply.Err)}, nil
+}
+
+func decodeGRPCUpdateLocationResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.UpdateLocationReply)
+	return updateLocationResponse{Err: str2err(reply.Err)}, nil
+}
+
+func decodeGRPCLoadLocationResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.LoadLocationReply)
+	if reply.Location == nil {
+		return loadLocationResponse{Err: str2err(reply.Err)}, nil
+	}
+	dl := decodeLocation(reply.Location)
+	l := assemble(&dl)
+	return loadLocationResponse{Location: &l, Err: str2err(reply.Err)}, nil
+}
+
+func decodeGRPCListLocationsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ListLocationsReply)
+	locations := make([]Location, 0, len(reply.Locations))
+	for _, l := range reply.Locations {
+		dl := decodeLocation(l)
+		locations = append(locations, assemble(&dl))
+	}
+	return listLocationsResponse{Locations: locations}, nil
+}
+
+func decodeGRPCImportLocationsResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ImportLocationsReply)
+	return importLocationsResponse{Imported: int(reply.Imported), Err: str2err(reply.Err)}, nil
+}
+
+func decodeGRPCDistanceResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.DistanceReply)
+	return distanceResponse{Kilometres: reply.Km, Err: str2err(reply.Err)}, nil
+}
+
+func encodeLocation(l location.Location) *pb.Location {
+	result := &pb.Location{
+		Unlocode:    string(l.UNLcode),
+		Name:        l.Name,
+		Country:     l.Country,
+		Subdivision: l.Subdivision,
+		Functions:   l.Functions,
+		TimeZone:    l.TimeZone,
+	}
+	if c := l.Coordinates; c != nil {
+		result.Coordinates = &pb.Coordinates{Latitude: c.Latitude, Longitude: c.Longitude}
+	}
+	return result
+}
+
+func decodeLocation(l *pb.Location) location.Location {
+	if l == nil {
+		return location.Location{}
+	}
+	result := location.Location{
+		UNLcode:     location.UNLcode(l.Unlocode),
+		Name:        l.Name,
+		Country:     l.Country,
+		Subdivision: l.Subdivision,
+		Functions:   l.Functions,
+		TimeZone:    l.TimeZone,
+	}
+	if c := l.Coordinates; c != nil {
+		result.Coordinates = &location.Coordinates{Latitude: c.Latitude, Longitude: c.Longitude}
+	}
+	return result
+}
+
+func err2str(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func str2err(s string) error {
+	if s == "" {
+		return nil
+	}
+	return errors.New(s)
+}
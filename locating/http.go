@@ -0,0 +1,179 @@
+package locating
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+
+	"github.com/Qalifah/shipping/location"
+)
+
+// MakeHandler returns a handler for the locating service.
+func MakeHandler(s Service, logger kitlog.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(encodeError),
+	}
+
+	createLocationHandler := kithttp.NewServer(
+		makeCreateLocationEndpoint(s),
+		decodeCreateLocationRequest,
+		encodeResponse,
+		opts...,
+	)
+	listLocationsHandler := kithttp.NewServer(
+		makeListLocationsEndpoint(s),
+		decodeListLocationsRequest,
+		encodeResponse,
+		opts...,
+	)
+	importLocationsHandler := kithttp.NewServer(
+		makeImportLocationsEndpoint(s),
+		decodeImportLocationsRequest,
+		encodeResponse,
+		opts...,
+	)
+	loadLocationHandler := kithttp.NewServer(
+		makeLoadLocationEndpoint(s),
+		decodeLoadLocationRequest,
+		encodeResponse,
+		opts...,
+	)
+	updateLocationHandler := kithttp.NewServer(
+		makeUpdateLocationEndpoint(s),
+		decodeUpdateLocationRequest,
+		encodeResponse,
+		opts...,
+	)
+	distanceHandler := kithttp.NewServer(
+		makeDistanceEndpoint(s),
+		decodeDistanceRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	r := mux.NewRouter()
+
+	r.Handle("/locating/v1/locations", createLocationHandler).Methods("POST")
+	r.Handle("/locating/v1/locations", listLocationsHandler).Methods("GET")
+	r.Handle("/locating/v1/locations/import", importLocationsHandler).Methods("POST")
+	r.Handle("/locating/v1/locations/{code}", loadLocationHandler).Methods("GET")
+	r.Handle("/locating/v1/locations/{code}", updateLocationHandler).Methods("PUT")
+	r.Handle("/locating/v1/distance", distanceHandler).Methods("GET")
+
+	return r
+}
+
+var errBadRoute = errors.New("bad route")
+
+func decodeCreateLocationRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var body Location
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	return createLocationRequest{Location: toLocation(body)}, nil
+}
+
+func decodeListLocationsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return listLocationsRequest{Country: r.URL.Query().Get("country")}, nil
+}
+
+// decodeImportLocationsRequest reads a file of the UN/LOCODE code list in
+// its CSV distribution from the request body.
+func decodeImportLocationsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	locations, err := location.ReadUNLOCODE(r.Body)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+	return importLocationsRequest{Locations: locations}, nil
+}
+
+func decodeLoadLocationRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	code, ok := vars["code"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return loadLocationRequest{UNLocode: location.UNLcode(code)}, nil
+}
+
+func decodeUpdateLocationRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	code, ok := vars["code"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body Location
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	body.UNLocode = code
+
+	return updateLocationRequest{Location: toLocation(body)}, nil
+}
+
+func decodeDistanceRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	return distanceRequest{
+		From: location.UNLcode(q.Get("from")),
+		To:   location.UNLcode(q.Get("to")),
+	}, nil
+}
+
+func toLocation(l Location) location.Location {
+	return location.Location{
+		UNLcode:     location.UNLcode(l.UNLocode),
+		Name:        l.Name,
+		Country:     l.Country,
+		Subdivision: l.Subdivision,
+		Functions:   l.Functions,
+		Coordinates: toCoordinates(l.Coordinates),
+		TimeZone:    l.TimeZone,
+	}
+}
+
+func toCoordinates(c *Coordinates) *location.Coordinates {
+	if c == nil {
+		return nil
+	}
+	return &location.Coordinates{Latitude: c.Latitude, Longitude: c.Longitude}
+}
+
+func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
+
+type errorer interface {
+	error() error
+}
+
+// encode errors from business-logic
+func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch err {
+	case location.ErrUnknown:
+		w.WriteHeader(http.StatusNotFound)
+	case ErrInvalidArgument, location.ErrNoCoordinates:
+		w.WriteHeader(http.StatusBadRequest)
+	case ErrLocationExists:
+		w.WriteHeader(http.StatusConflict)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,78 @@
+package locating
+
+import (
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+
+	"github.com/Qalifah/shipping/location"
+)
+
+type instrumentingService struct {
+	requestCount   metrics.Counter
+	requestLatency metrics.Histogram
+	Service
+}
+
+// NewInstrumentingService returns an instance of an instrumenting Service.
+func NewInstrumentingService(counter metrics.Counter, latency metrics.Histogram, s Service) Service {
+	return &instrumentingService{
+		requestCount:   counter,
+		requestLatency: latency,
+		Service:        s,
+	}
+}
+
+func (s *instrumentingService) CreateLocation(l location.Location) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "create_location").Add(1)
+		s.requestLatency.With("method", "create_location").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.CreateLocation(l)
+}
+
+func (s *instrumentingService) UpdateLocation(l location.Location) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "update_location").Add(1)
+		s.requestLatency.With("method", "update_location").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.UpdateLocation(l)
+}
+
+func (s *instrumentingService) LoadLocation(code location.UNLcode) (Location, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "load_location").Add(1)
+		s.requestLatency.With("method", "load_location").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.LoadLocation(code)
+}
+
+func (s *instrumentingService) Locations(country string) []Location {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_locations").Add(1)
+		s.requestLatency.With("method", "list_locations").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Locations(country)
+}
+
+func (s *instrumentingService) ImportLocations(locations []*location.Location) (int, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "import_locations").Add(1)
+		s.requestLatency.With("method", "import_locations").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.ImportLocations(locations)
+}
+
+func (s *instrumentingService) Distance(from, to location.UNLcode) (float64, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "distance").Add(1)
+		s.requestLatency.With("method", "distance").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Distance(from, to)
+}
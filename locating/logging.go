@@ -0,0 +1,93 @@
+package locating
+
+import (
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/Qalifah/shipping/location"
+)
+
+type loggingService struct {
+	logger log.Logger
+	Service
+}
+
+// NewLoggingService returns a new instance of a logging Service.
+func NewLoggingService(logger log.Logger, s Service) Service {
+	return &loggingService{logger, s}
+}
+
+func (s *loggingService) CreateLocation(l location.Location) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "create_location",
+			"location", l.UNLcode,
+			"name", l.Name,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.CreateLocation(l)
+}
+
+func (s *loggingService) UpdateLocation(l location.Location) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "update_location",
+			"location", l.UNLcode,
+			"name", l.Name,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.UpdateLocation(l)
+}
+
+func (s *loggingService) LoadLocation(code location.UNLcode) (l Location, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "load_location",
+			"location", code,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.LoadLocation(code)
+}
+
+func (s *loggingService) Locations(country string) []Location {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_locations",
+			"country", country,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	return s.Service.Locations(country)
+}
+
+func (s *loggingService) Distance(from, to location.UNLcode) (km float64, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "distance",
+			"from", from,
+			"to", to,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Distance(from, to)
+}
+
+func (s *loggingService) ImportLocations(locations []*location.Location) (n int, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "import_locations",
+			"imported", n,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ImportLocations(locations)
+}
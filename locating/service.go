@@ -0,0 +1,208 @@
+// Package locating provides the use-cases for managing the locations known
+// to the application.
+package locating
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Qalifah/shipping/location"
+)
+
+// ErrInvalidArgument is returned when one or more arguments are invalid
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrLocationExists is returned when creating a location with a UN/LOCODE
+// already in use
+var ErrLocationExists = errors.New("location already exists")
+
+// Service is the interface that provides location management methods.
+type Service interface {
+	// CreateLocation registers a new location. The country defaults to the
+	// first two letters of the UN/LOCODE.
+	CreateLocation(l location.Location) error
+
+	// UpdateLocation replaces the details of a registered location.
+	UpdateLocation(l location.Location) error
+
+	// LoadLocation returns a read model of a location.
+	LoadLocation(code location.UNLcode) (Location, error)
+
+	// Locations returns the registered locations, restricted to a country
+	// unless it is empty.
+	Locations(country string) []Location
+
+	// ImportLocations registers or updates the locations in bulk, as read
+	// from the UN/LOCODE code list, and returns the number imported.
+	ImportLocations(locations []*location.Location) (int, error)
+
+	// Distance returns the great-circle distance between two locations in
+	// kilometres.
+	Distance(from, to location.UNLcode) (float64, error)
+}
+
+type service struct {
+	mtx       sync.Mutex
+	locations location.Repository
+}
+
+func (s *service) CreateLocation(l location.Location) error {
+	if err := normalize(&l); err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, err := s.locations.Find(l.UNLcode); err == nil {
+		return ErrLocationExists
+	}
+	return s.locations.Store(&l)
+}
+
+func (s *service) UpdateLocation(l location.Location) error {
+	if err := normalize(&l); err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, err := s.locations.Find(l.UNLcode); err != nil {
+		return err
+	}
+	return s.locations.Store(&l)
+}
+
+func (s *service) LoadLocation(code location.UNLcode) (Location, error) {
+	if code == "" {
+		return Location{}, ErrInvalidArgument
+	}
+	l, err := s.locations.Find(code)
+	if err != nil {
+		return Location{}, err
+	}
+	return assemble(l), nil
+}
+
+func (s *service) Locations(country string) []Location {
+	result := make([]Location, 0)
+	for _, l := range s.locations.FindAll() {
+		if country != "" && !strings.EqualFold(l.Country, country) {
+			continue
+		}
+		result = append(result, assemble(l))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].UNLocode < result[j].UNLocode })
+	return result
+}
+
+func (s *service) Distance(from, to location.UNLcode) (float64, error) {
+	if from == "" || to == "" {
+		return 0, ErrInvalidArgument
+	}
+	return location.Distance(s.locations, from, to)
+}
+
+func (s *service) ImportLocations(locations []*location.Location) (int, error) {
+	for _, l := range locations {
+		if err := normalize(l); err != nil {
+			return 0, err
+		}
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// The code list has no time zones, and not always coordinates, so keep
+	// those already known.
+	for _, l := range locations {
+		if l.TimeZone != "" && l.Coordinates != nil {
+			continue
+		}
+		if known, err := s.locations.Find(l.UNLcode); err == nil {
+			if l.TimeZone == "" {
+				l.TimeZone = known.TimeZone
+			}
+			if l.Coordinates == nil {
+				l.Coordinates = known.Coordinates
+			}
+		}
+	}
+
+	if err := s.locations.Store(locations...); err != nil {
+		return 0, err
+	}
+	return len(locations), nil
+}
+
+// normalize upper-cases the codes of a location and checks that it is
+// complete and consistent, and that its time zone is known.
+func normalize(l *location.Location) error {
+	l.UNLcode = location.UNLcode(strings.ToUpper(string(l.UNLcode)))
+	l.Country = strings.ToUpper(l.Country)
+	l.Subdivision = strings.ToUpper(l.Subdivision)
+
+	if len(l.UNLcode) != 5 || l.Name == "" {
+		return ErrInvalidArgument
+	}
+	if l.Country == "" {
+		l.Country = string(l.UNLcode[:2])
+	}
+	if l.Country != string(l.UNLcode[:2]) {
+		return ErrInvalidArgument
+	}
+	if c := l.Coordinates; c != nil {
+		if c.Latitude < -90 || c.Latitude > 90 || c.Longitude < -180 || c.Longitude > 180 {
+			return ErrInvalidArgument
+		}
+	}
+	if l.TimeZone != "" {
+		if _, err := time.LoadLocation(l.TimeZone); err != nil {
+			return ErrInvalidArgument
+		}
+	}
+	return nil
+}
+
+// NewService creates a locating service with necessary dependencies.
+func NewService(locations location.Repository) Service {
+	return &service{
+		locations: locations,
+	}
+}
+
+// Location is a read model for location views.
+type Location struct {
+	UNLocode    string       `json:"unlocode"`
+	Name        string       `json:"name"`
+	Country     string       `json:"country"`
+	Subdivision string       `json:"subdivision,omitempty"`
+	Functions   string       `json:"functions,omitempty"`
+	Coordinates *Coordinates `json:"coordinates,omitempty"`
+	TimeZone    string       `json:"time_zone,omitempty"`
+}
+
+// Coordinates is a read model for the position of a location.
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func assemble(l *location.Location) Location {
+	result := Location{
+		UNLocode:    string(l.UNLcode),
+		Name:        l.Name,
+		Country:     l.Country,
+		Subdivision: l.Subdivision,
+		Functions:   l.Functions,
+		TimeZone:    l.TimeZone,
+	}
+	if c := l.Coordinates; c != nil {
+		result.Coordinates = &Coordinates{Latitude: c.Latitude, Longitude: c.Longitude}
+	}
+	return result
+}
@@ -0,0 +1,92 @@
+package location
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ErrNoCoordinates is used when the distance to or from a location is
+// requested, but its coordinates are not known
+var ErrNoCoordinates = errors.New("location has no coordinates")
+
+// earthRadius is the mean radius of the Earth in kilometres.
+const earthRadius = 6371.0
+
+// Coordinates is a position on the Earth, in decimal degrees.
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// ParseCoordinates parses coordinates in the notation of the UN/LOCODE code
+// list, degrees and minutes followed by the hemisphere, e.g. "5953N 01803E".
+func ParseCoordinates(s string) (Coordinates, error) {
+	var lat, lon string
+	if _, err := fmt.Sscan(s, &lat, &lon); err != nil || len(lat) != 5 || len(lon) != 6 {
+		return Coordinates{}, fmt.Errorf("invalid coordinates %q", s)
+	}
+
+	latitude, err := parseDegrees(lat, 'N', 'S')
+	if err != nil || latitude < -90 || latitude > 90 {
+		return Coordinates{}, fmt.Errorf("invalid latitude %q", lat)
+	}
+	longitude, err := parseDegrees(lon, 'E', 'W')
+	if err != nil || longitude < -180 || longitude > 180 {
+		return Coordinates{}, fmt.Errorf("invalid longitude %q", lon)
+	}
+
+	return Coordinates{Latitude: latitude, Longitude: longitude}, nil
+}
+
+// parseDegrees parses degrees and two digits of minutes followed by the
+// positive or negative hemisphere.
+func parseDegrees(s string, pos, neg byte) (float64, error) {
+	n := len(s) - 1
+	deg, err := strconv.Atoi(s[:n-2])
+	if err != nil {
+		return 0, err
+	}
+	min, err := strconv.Atoi(s[n-2 : n])
+	if err != nil || min >= 60 {
+		return 0, fmt.Errorf("invalid minutes in %q", s)
+	}
+
+	d := float64(deg) + float64(min)/60
+	switch s[n] {
+	case pos:
+		return d, nil
+	case neg:
+		return -d, nil
+	}
+	return 0, fmt.Errorf("invalid hemisphere in %q", s)
+}
+
+// DistanceTo returns the great-circle distance to other in kilometres.
+func (c Coordinates) DistanceTo(other Coordinates) float64 {
+	lat1 := c.Latitude * math.Pi / 180
+	lat2 := other.Latitude * math.Pi / 180
+	dlat := lat2 - lat1
+	dlon := (other.Longitude - c.Longitude) * math.Pi / 180
+
+	h := math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dlon/2)*math.Sin(dlon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Distance returns the great-circle distance between two locations in the
+// repository, in kilometres.
+func Distance(r Repository, from, to UNLcode) (float64, error) {
+	a, err := r.Find(from)
+	if err != nil {
+		return 0, err
+	}
+	b, err := r.Find(to)
+	if err != nil {
+		return 0, err
+	}
+	if a.Coordinates == nil || b.Coordinates == nil {
+		return 0, ErrNoCoordinates
+	}
+	return a.Coordinates.DistanceTo(*b.Coordinates), nil
+}
@@ -9,13 +9,35 @@ type UNLcode string
 type Location struct {
 	UNLcode UNLcode
 	Name	string
+
+	// Country is the ISO 3166 alpha-2 code of the country, the first two
+	// letters of the UN/LOCODE.
+	Country	string
+
+	// Subdivision is the ISO 3166-2 code of the region or state within the
+	// country, without the country prefix, if known.
+	Subdivision	string
+
+	// Functions is the UN/LOCODE function classifier, eight characters each
+	// marking a kind of facility at the location, e.g. "1" in the first
+	// position for a port, or "0" if the functions are not known.
+	Functions	string
+
+	// Coordinates is the position of the location, or nil if not known.
+	Coordinates	*Coordinates
+
+	// TimeZone is the name of the location's time zone in the IANA time
+	// zone database, e.g. "Europe/Stockholm", or empty if not known.
+	TimeZone	string
 }
 
 // ErrUnknown is used when a location can't be found
 var ErrUnknown = errors.New("unknown location")
 
-// Repository represents a location store
+// Repository represents a location store. Store adds or replaces locations
+// all at once, so that large datasets can be imported efficiently.
 type Repository interface {
+	Store(...*Location) error
 	Find(UNLcode) (*Location, error)
 	FindAll() []*Location
-}
\ No newline at end of file
+}
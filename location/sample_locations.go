@@ -15,13 +15,13 @@ var (
 
 // Sample locations.
 var (
-	Stockholm = &Location{SESTO, "Stockholm"}
-	Melbourne = &Location{AUMEL, "Melbourne"}
-	Hongkong  = &Location{CNHKG, "Hongkong"}
-	NewYork   = &Location{USNYC, "New York"}
-	Chicago   = &Location{USCHI, "Chicago"}
-	Tokyo     = &Location{JNTKO, "Tokyo"}
-	Hamburg   = &Location{DEHAM, "Hamburg"}
-	Rotterdam = &Location{NLRTM, "Rotterdam"}
-	Helsinki  = &Location{FIHEL, "Helsinki"}
-)
\ No newline at end of file
+	Stockholm = &Location{UNLcode: SESTO, Name: "Stockholm", Country: "SE", Coordinates: &Coordinates{59.33, 18.05}, TimeZone: "Europe/Stockholm"}
+	Melbourne = &Location{UNLcode: AUMEL, Name: "Melbourne", Country: "AU", Coordinates: &Coordinates{-37.82, 144.97}, TimeZone: "Australia/Melbourne"}
+	Hongkong  = &Location{UNLcode: CNHKG, Name: "Hongkong", Country: "CN", Coordinates: &Coordinates{22.28, 114.17}, TimeZone: "Asia/Hong_Kong"}
+	NewYork   = &Location{UNLcode: USNYC, Name: "New York", Country: "US", Coordinates: &Coordinates{40.70, -74.00}, TimeZone: "America/New_York"}
+	Chicago   = &Location{UNLcode: USCHI, Name: "Chicago", Country: "US", Coordinates: &Coordinates{41.85, -87.65}, TimeZone: "America/Chicago"}
+	Tokyo     = &Location{UNLcode: JNTKO, Name: "Tokyo", Country: "JN", Coordinates: &Coordinates{35.68, 139.68}, TimeZone: "Asia/Tokyo"}
+	Hamburg   = &Location{UNLcode: DEHAM, Name: "Hamburg", Country: "DE", Coordinates: &Coordinates{53.55, 9.98}, TimeZone: "Europe/Berlin"}
+	Rotterdam = &Location{UNLcode: NLRTM, Name: "Rotterdam", Country: "NL", Coordinates: &Coordinates{51.92, 4.48}, TimeZone: "Europe/Amsterdam"}
+	Helsinki  = &Location{UNLcode: FIHEL, Name: "Helsinki", Country: "FI", Coordinates: &Coordinates{60.17, 24.93}, TimeZone: "Europe/Helsinki"}
+)
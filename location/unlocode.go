@@ -0,0 +1,92 @@
+package location
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"unicode/utf8"
+)
+
+// the columns of the UN/LOCODE code list
+const (
+	colChange = iota
+	colCountry
+	colLocation
+	colName
+	colNameWoDiacritics
+	colSubdivision
+	colFunction
+	colStatus
+	colDate
+	colIATA
+	colCoordinates
+	colRemarks
+	numColumns
+)
+
+// ReadUNLOCODE reads locations from the CSV distribution of the UN/LOCODE
+// code list, in any of its parts. Country header rows, entries marked for
+// removal (X) and references to other entries (=) are skipped. The official
+// files are encoded in ISO 8859-1, which is converted, but UTF-8 input is
+// accepted as well. The code list has no time zones, so those are left empty.
+func ReadUNLOCODE(r io.Reader) ([]*Location, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.Valid(b) {
+		b = latin1ToUTF8(b)
+	}
+
+	cr := csv.NewReader(strings.NewReader(string(b)))
+	cr.FieldsPerRecord = -1
+
+	var locations []*Location
+	for row := 1; ; row++ {
+		fields, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) < numColumns-1 {
+			return nil, fmt.Errorf("row %d: expected %d fields, got %d", row, numColumns, len(fields))
+		}
+
+		switch strings.TrimSpace(fields[colChange]) {
+		case "X", "=":
+			continue
+		}
+		country := strings.TrimSpace(fields[colCountry])
+		code := strings.TrimSpace(fields[colLocation])
+		if country == "" || code == "" {
+			continue
+		}
+
+		l := &Location{
+			UNLcode:     UNLcode(country + code),
+			Name:        strings.TrimSpace(fields[colName]),
+			Country:     country,
+			Subdivision: strings.TrimSpace(fields[colSubdivision]),
+			Functions:   strings.TrimSpace(fields[colFunction]),
+		}
+		// Coordinates are missing for many entries, and malformed for a
+		// few, neither of which should fail the whole import.
+		if c, err := ParseCoordinates(fields[colCoordinates]); err == nil {
+			l.Coordinates = &c
+		}
+		locations = append(locations, l)
+	}
+	return locations, nil
+}
+
+func latin1ToUTF8(b []byte) []byte {
+	buf := make([]byte, 0, len(b)+len(b)/8)
+	for _, c := range b {
+		buf = utf8.AppendRune(buf, rune(c))
+	}
+	return buf
+}
@@ -0,0 +1,115 @@
+package notifying
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrCallbackNotAllowed is returned when a callback URL points at a host that
+// notifications may not be posted to, such as a loopback or private address.
+var ErrCallbackNotAllowed = errors.New("callback host not allowed")
+
+// lookupTimeout bounds the resolution of a callback host when subscribing.
+const lookupTimeout = 5 * time.Second
+
+// callbackPolicy decides which hosts notifications may be posted to. Unless
+// a host is explicitly allowed, every address it resolves to must be public,
+// so that subscribers cannot have requests made into the internal network.
+type callbackPolicy struct {
+	allowed  map[string]bool
+	resolver *net.Resolver
+	dialer   *net.Dialer
+}
+
+func newCallbackPolicy(allowedHosts []string) *callbackPolicy {
+	p := &callbackPolicy{
+		allowed:  make(map[string]bool),
+		resolver: net.DefaultResolver,
+		dialer:   &net.Dialer{Timeout: 5 * time.Second, KeepAlive: 30 * time.Second},
+	}
+	for _, h := range allowedHosts {
+		if h = strings.ToLower(strings.TrimSpace(h)); h != "" {
+			p.allowed[h] = true
+		}
+	}
+	return p
+}
+
+// check resolves a host, and returns its addresses if notifications may be
+// posted to them. The addresses of explicitly allowed hosts are not
+// resolved.
+func (p *callbackPolicy) check(ctx context.Context, host string) ([]net.IP, error) {
+	host = strings.ToLower(host)
+	if p.allowed[host] {
+		return nil, nil
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if !public(ip) {
+			return nil, ErrCallbackNotAllowed
+		}
+		return []net.IP{ip}, nil
+	}
+
+	addrs, err := p.resolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	ips := make([]net.IP, 0, len(addrs))
+	for _, a := range addrs {
+		if !public(a.IP) {
+			return nil, ErrCallbackNotAllowed
+		}
+		ips = append(ips, a.IP)
+	}
+	if len(ips) == 0 {
+		return nil, &net.DNSError{Err: "no addresses", Name: host, IsNotFound: true}
+	}
+	return ips, nil
+}
+
+// dialContext dials only the addresses that pass the check, so that neither
+// a redirect nor a DNS record changed after subscribing can lead elsewhere.
+func (p *callbackPolicy) dialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := p.check(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if ips == nil {
+		return p.dialer.DialContext(ctx, network, addr)
+	}
+
+	for _, ip := range ips {
+		var conn net.Conn
+		conn, err = p.dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+// client returns a client that posts only to the hosts the policy allows.
+func (p *callbackPolicy) client() *http.Client {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = nil
+	t.DialContext = p.dialContext
+	return &http.Client{Transport: t, Timeout: 10 * time.Second}
+}
+
+func public(ip net.IP) bool {
+	return !(ip.IsLoopback() ||
+		ip.IsPrivate() ||
+		ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast())
+}
@@ -0,0 +1,44 @@
+package notifying
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCallbackPolicyCheck(t *testing.T) {
+	p := newCallbackPolicy([]string{" Hooks.Internal ", ""})
+
+	for _, tt := range []struct {
+		host string
+		err  error
+	}{
+		{host: "127.0.0.1", err: ErrCallbackNotAllowed},
+		{host: "::1", err: ErrCallbackNotAllowed},
+		{host: "10.1.2.3", err: ErrCallbackNotAllowed},
+		{host: "172.16.0.1", err: ErrCallbackNotAllowed},
+		{host: "192.168.1.1", err: ErrCallbackNotAllowed},
+		{host: "fd00::1", err: ErrCallbackNotAllowed},
+		{host: "169.254.169.254", err: ErrCallbackNotAllowed},
+		{host: "fe80::1", err: ErrCallbackNotAllowed},
+		{host: "0.0.0.0", err: ErrCallbackNotAllowed},
+		{host: "224.0.0.1", err: ErrCallbackNotAllowed},
+		{host: "93.184.216.34"},
+		{host: "2606:2800:220:1:248:1893:25c8:1946"},
+		// Allowed hosts are not resolved, so need not exist.
+		{host: "hooks.internal"},
+		{host: "HOOKS.internal"},
+	} {
+		if _, err := p.check(context.Background(), tt.host); err != tt.err {
+			t.Errorf("%s: got %v, want %v", tt.host, err, tt.err)
+		}
+	}
+}
+
+func TestCallbackPolicyCheckResolvesHosts(t *testing.T) {
+	p := newCallbackPolicy(nil)
+
+	// localhost resolves to a loopback address without a DNS server.
+	if _, err := p.check(context.Background(), "localhost"); err != ErrCallbackNotAllowed {
+		t.Errorf("got %v, want %v", err, ErrCallbackNotAllowed)
+	}
+}
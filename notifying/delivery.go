@@ -0,0 +1,87 @@
+package notifying
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pborman/uuid"
+)
+
+// Headers of the requests posted to subscribers. The signature is the hex
+// encoded HMAC-SHA256, keyed with the subscription secret, of the timestamp,
+// a dot and the request body, so that subscribers can check both that the
+// request is authentic and that it is recent.
+const (
+	HeaderDelivery  = "X-Shipping-Delivery"
+	HeaderTimestamp = "X-Shipping-Timestamp"
+	HeaderSignature = "X-Shipping-Signature"
+)
+
+func newDelivery(id SubscriptionID, e Event) *Delivery {
+	return &Delivery{
+		ID:             strings.ToUpper(uuid.New()),
+		SubscriptionID: id,
+		Event:          e,
+		Status:         StatusPending,
+		Created:        time.Now(),
+	}
+}
+
+// payload is the body posted to subscribers.
+type payload struct {
+	DeliveryID     string         `json:"delivery_id"`
+	SubscriptionID SubscriptionID `json:"subscription_id"`
+	Event
+}
+
+// post posts a delivery to a callback URL, and returns the status code of
+// the response, if any. Any response but a 2xx status is an error.
+func post(client *http.Client, callback, secret string, d *Delivery) (int, error) {
+	body, err := json.Marshal(payload{
+		DeliveryID:     d.ID,
+		SubscriptionID: d.SubscriptionID,
+		Event:          d.Event,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequest("POST", callback, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set(HeaderDelivery, d.ID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, "sha256="+Sign(secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("subscriber responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature of a request body posted at the timestamp, as
+// sent in the signature header without its "sha256=" prefix.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,18 @@
+package notifying
+
+import "testing"
+
+func TestSign(t *testing.T) {
+	got := Sign("secret", "1700000000", []byte(`{"type":"cargo_handled"}`))
+	if want := "035fbdad72b9df74b4aa0c6e6133ba13165e10d88b5297fff190720aee8109e1"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	// The timestamp is part of what is signed.
+	if Sign("secret", "1700000001", []byte(`{"type":"cargo_handled"}`)) == got {
+		t.Error("signature does not depend on the timestamp")
+	}
+	if Sign("other", "1700000000", []byte(`{"type":"cargo_handled"}`)) == got {
+		t.Error("signature does not depend on the secret")
+	}
+}
@@ -0,0 +1,135 @@
+package notifying
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"github.com/Qalifah/shipping/cargo"
+)
+
+type subscribeRequest struct {
+	TrackingIDs []cargo.TrackingID
+	URL         string
+}
+
+type subscribeResponse struct {
+	Subscription *Subscription `json:"subscription,omitempty"`
+	Err          error         `json:"error,omitempty"`
+}
+
+func (r subscribeResponse) error() error { return r.Err }
+
+func makeSubscribeEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(subscribeRequest)
+		sub, err := s.Subscribe(req.TrackingIDs, req.URL)
+		return subscribeResponse{Subscription: &sub, Err: err}, nil
+	}
+}
+
+type unsubscribeRequest struct {
+	ID SubscriptionID
+}
+
+type unsubscribeResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r unsubscribeResponse) error() error { return r.Err }
+
+func makeUnsubscribeEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(unsubscribeRequest)
+		err := s.Unsubscribe(req.ID)
+		return unsubscribeResponse{Err: err}, nil
+	}
+}
+
+type loadSubscriptionRequest struct {
+	ID SubscriptionID
+}
+
+type loadSubscriptionResponse struct {
+	Subscription *Subscription `json:"subscription,omitempty"`
+	Err          error         `json:"error,omitempty"`
+}
+
+func (r loadSubscriptionResponse) error() error { return r.Err }
+
+func makeLoadSubscriptionEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(loadSubscriptionRequest)
+		sub, err := s.LoadSubscription(req.ID)
+		return loadSubscriptionResponse{Subscription: &sub, Err: err}, nil
+	}
+}
+
+type listSubscriptionsRequest struct{}
+
+type listSubscriptionsResponse struct {
+	Subscriptions []Subscription `json:"subscriptions,omitempty"`
+	Err           error          `json:"error,omitempty"`
+}
+
+func (r listSubscriptionsResponse) error() error { return r.Err }
+
+func makeListSubscriptionsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(listSubscriptionsRequest)
+		return listSubscriptionsResponse{Subscriptions: s.Subscriptions(), Err: nil}, nil
+	}
+}
+
+type listDeliveriesRequest struct {
+	ID SubscriptionID
+}
+
+type listDeliveriesResponse struct {
+	Deliveries []Delivery `json:"deliveries,omitempty"`
+	Err        error      `json:"error,omitempty"`
+}
+
+func (r listDeliveriesResponse) error() error { return r.Err }
+
+func makeListDeliveriesEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listDeliveriesRequest)
+		deliveries, err := s.Deliveries(req.ID)
+		return listDeliveriesResponse{Deliveries: deliveries, Err: err}, nil
+	}
+}
+
+type listDeadLettersRequest struct{}
+
+type listDeadLettersResponse struct {
+	DeadLetters []Delivery `json:"dead_letters,omitempty"`
+	Err         error      `json:"error,omitempty"`
+}
+
+func (r listDeadLettersResponse) error() error { return r.Err }
+
+func makeListDeadLettersEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(listDeadLettersRequest)
+		return listDeadLettersResponse{DeadLetters: s.DeadLetters(), Err: nil}, nil
+	}
+}
+
+type retryDeadLetterRequest struct {
+	ID string
+}
+
+type retryDeadLetterResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r retryDeadLetterResponse) error() error { return r.Err }
+
+func makeRetryDeadLetterEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(retryDeadLetterRequest)
+		err := s.RetryDeadLetter(req.ID)
+		return retryDeadLetterResponse{Err: err}, nil
+	}
+}
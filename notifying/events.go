@@ -0,0 +1,61 @@
+package notifying
+
+import (
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/handling"
+	"github.com/Qalifah/shipping/inspection"
+)
+
+type handlingEventHandler struct {
+	next handling.EventHandler
+	s    Service
+}
+
+func (h *handlingEventHandler) CargoWasHandled(e cargo.HandlingEvent) {
+	h.next.CargoWasHandled(e)
+	h.s.Publish(Event{
+		Type:         CargoHandled,
+		TrackingID:   string(e.TrackingID),
+		Activity:     e.Activity.Type.String(),
+		Location:     string(e.Activity.Location),
+		VoyageNumber: string(e.Activity.VoyageNumber),
+		Time:         e.Completed,
+	})
+}
+
+// NewHandlingEventHandler returns a handling.EventHandler that passes each
+// handling event on to next, and then publishes it to subscribers.
+func NewHandlingEventHandler(next handling.EventHandler, s Service) handling.EventHandler {
+	return &handlingEventHandler{next: next, s: s}
+}
+
+type inspectionChannel struct {
+	s Service
+}
+
+func (c *inspectionChannel) Notify(n inspection.Notification) error {
+	var t EventType
+	switch n.Type {
+	case inspection.CargoMisdirected:
+		t = CargoMisdirected
+	case inspection.CargoArrived:
+		t = CargoArrived
+	case inspection.CargoDelayed:
+		t = CargoDelayed
+	default:
+		return nil
+	}
+	c.s.Publish(Event{
+		Type:       t,
+		TrackingID: n.TrackingID,
+		Location:   n.LastKnownLocation,
+		Time:       n.Time,
+	})
+	return nil
+}
+
+// NewInspectionChannel returns an inspection.Channel that publishes the
+// misdirection, arrival and delay of cargos to subscribers.
+func NewInspectionChannel(s Service) inspection.Channel {
+	return &inspectionChannel{s: s}
+}
@@ -0,0 +1,181 @@
+package notifying
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+
+	"github.com/Qalifah/shipping/cargo"
+)
+
+// MakeHandler returns a handler for the notifying service.
+func MakeHandler(s Service, logger kitlog.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(encodeError),
+	}
+
+	subscribeHandler := kithttp.NewServer(
+		makeSubscribeEndpoint(s),
+		decodeSubscribeRequest,
+		encodeResponse,
+		opts...,
+	)
+	listSubscriptionsHandler := kithttp.NewServer(
+		makeListSubscriptionsEndpoint(s),
+		decodeListSubscriptionsRequest,
+		encodeResponse,
+		opts...,
+	)
+	loadSubscriptionHandler := kithttp.NewServer(
+		makeLoadSubscriptionEndpoint(s),
+		decodeLoadSubscriptionRequest,
+		encodeResponse,
+		opts...,
+	)
+	unsubscribeHandler := kithttp.NewServer(
+		makeUnsubscribeEndpoint(s),
+		decodeUnsubscribeRequest,
+		encodeResponse,
+		opts...,
+	)
+	listDeliveriesHandler := kithttp.NewServer(
+		makeListDeliveriesEndpoint(s),
+		decodeListDeliveriesRequest,
+		encodeResponse,
+		opts...,
+	)
+	listDeadLettersHandler := kithttp.NewServer(
+		makeListDeadLettersEndpoint(s),
+		decodeListDeadLettersRequest,
+		encodeResponse,
+		opts...,
+	)
+	retryDeadLetterHandler := kithttp.NewServer(
+		makeRetryDeadLetterEndpoint(s),
+		decodeRetryDeadLetterRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	r := mux.NewRouter()
+
+	r.Handle("/notifying/v1/subscriptions", subscribeHandler).Methods("POST")
+	r.Handle("/notifying/v1/subscriptions", listSubscriptionsHandler).Methods("GET")
+	r.Handle("/notifying/v1/subscriptions/{id}", loadSubscriptionHandler).Methods("GET")
+	r.Handle("/notifying/v1/subscriptions/{id}", unsubscribeHandler).Methods("DELETE")
+	r.Handle("/notifying/v1/subscriptions/{id}/deliveries", listDeliveriesHandler).Methods("GET")
+	r.Handle("/notifying/v1/dead_letters", listDeadLettersHandler).Methods("GET")
+	r.Handle("/notifying/v1/dead_letters/{id}/retry", retryDeadLetterHandler).Methods("POST")
+
+	return r
+}
+
+var errBadRoute = errors.New("bad route")
+
+func decodeSubscribeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var body struct {
+		TrackingIDs []string `json:"tracking_ids"`
+		URL         string   `json:"url"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	ids := make([]cargo.TrackingID, len(body.TrackingIDs))
+	for i, id := range body.TrackingIDs {
+		ids[i] = cargo.TrackingID(id)
+	}
+
+	return subscribeRequest{
+		TrackingIDs: ids,
+		URL:         body.URL,
+	}, nil
+}
+
+func decodeListSubscriptionsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return listSubscriptionsRequest{}, nil
+}
+
+func decodeLoadSubscriptionRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := subscriptionID(r)
+	if err != nil {
+		return nil, err
+	}
+	return loadSubscriptionRequest{ID: id}, nil
+}
+
+func decodeUnsubscribeRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := subscriptionID(r)
+	if err != nil {
+		return nil, err
+	}
+	return unsubscribeRequest{ID: id}, nil
+}
+
+func decodeListDeliveriesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	id, err := subscriptionID(r)
+	if err != nil {
+		return nil, err
+	}
+	return listDeliveriesRequest{ID: id}, nil
+}
+
+func decodeListDeadLettersRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return listDeadLettersRequest{}, nil
+}
+
+func decodeRetryDeadLetterRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return retryDeadLetterRequest{ID: id}, nil
+}
+
+func subscriptionID(r *http.Request) (SubscriptionID, error) {
+	vars := mux.Vars(r)
+	id, ok := vars["id"]
+	if !ok {
+		return "", errBadRoute
+	}
+	return SubscriptionID(id), nil
+}
+
+func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
+
+type errorer interface {
+	error() error
+}
+
+// encode errors from business-logic
+func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch err {
+	case cargo.ErrUnknown, ErrUnknownSubscription, ErrUnknownDelivery:
+		w.WriteHeader(http.StatusNotFound)
+	case ErrInvalidArgument, ErrCallbackNotAllowed:
+		w.WriteHeader(http.StatusBadRequest)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
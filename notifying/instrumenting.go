@@ -0,0 +1,96 @@
+package notifying
+
+import (
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+
+	"github.com/Qalifah/shipping/cargo"
+)
+
+type instrumentingService struct {
+	requestCount   metrics.Counter
+	requestLatency metrics.Histogram
+	Service
+}
+
+// NewInstrumentingService returns an instance of an instrumenting Service.
+func NewInstrumentingService(counter metrics.Counter, latency metrics.Histogram, s Service) Service {
+	return &instrumentingService{
+		requestCount:   counter,
+		requestLatency: latency,
+		Service:        s,
+	}
+}
+
+func (s *instrumentingService) Subscribe(ids []cargo.TrackingID, callback string) (Subscription, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "subscribe").Add(1)
+		s.requestLatency.With("method", "subscribe").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Subscribe(ids, callback)
+}
+
+func (s *instrumentingService) Unsubscribe(id SubscriptionID) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "unsubscribe").Add(1)
+		s.requestLatency.With("method", "unsubscribe").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Unsubscribe(id)
+}
+
+func (s *instrumentingService) LoadSubscription(id SubscriptionID) (Subscription, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "load_subscription").Add(1)
+		s.requestLatency.With("method", "load_subscription").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.LoadSubscription(id)
+}
+
+func (s *instrumentingService) Subscriptions() []Subscription {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_subscriptions").Add(1)
+		s.requestLatency.With("method", "list_subscriptions").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Subscriptions()
+}
+
+func (s *instrumentingService) Deliveries(id SubscriptionID) ([]Delivery, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_deliveries").Add(1)
+		s.requestLatency.With("method", "list_deliveries").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Deliveries(id)
+}
+
+func (s *instrumentingService) DeadLetters() []Delivery {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_dead_letters").Add(1)
+		s.requestLatency.With("method", "list_dead_letters").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DeadLetters()
+}
+
+func (s *instrumentingService) RetryDeadLetter(id string) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "retry_dead_letter").Add(1)
+		s.requestLatency.With("method", "retry_dead_letter").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.RetryDeadLetter(id)
+}
+
+func (s *instrumentingService) Publish(e Event) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "publish").Add(1)
+		s.requestLatency.With("method", "publish").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	s.Service.Publish(e)
+}
@@ -0,0 +1,114 @@
+package notifying
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/Qalifah/shipping/cargo"
+)
+
+type loggingService struct {
+	logger log.Logger
+	Service
+}
+
+// NewLoggingService returns a new instance of a logging Service.
+func NewLoggingService(logger log.Logger, s Service) Service {
+	return &loggingService{logger, s}
+}
+
+func (s *loggingService) Subscribe(ids []cargo.TrackingID, callback string) (sub Subscription, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "subscribe",
+			"tracking_ids", fmt.Sprint(ids),
+			"url", callback,
+			"subscription", sub.ID,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Subscribe(ids, callback)
+}
+
+func (s *loggingService) Unsubscribe(id SubscriptionID) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "unsubscribe",
+			"subscription", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Unsubscribe(id)
+}
+
+func (s *loggingService) LoadSubscription(id SubscriptionID) (sub Subscription, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "load_subscription",
+			"subscription", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.LoadSubscription(id)
+}
+
+func (s *loggingService) Subscriptions() []Subscription {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_subscriptions",
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	return s.Service.Subscriptions()
+}
+
+func (s *loggingService) Deliveries(id SubscriptionID) (deliveries []Delivery, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_deliveries",
+			"subscription", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.Deliveries(id)
+}
+
+func (s *loggingService) DeadLetters() []Delivery {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_dead_letters",
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	return s.Service.DeadLetters()
+}
+
+func (s *loggingService) RetryDeadLetter(id string) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "retry_dead_letter",
+			"delivery", id,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.RetryDeadLetter(id)
+}
+
+func (s *loggingService) Publish(e Event) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "publish",
+			"event", e.Type,
+			"tracking_id", e.TrackingID,
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	s.Service.Publish(e)
+}
@@ -0,0 +1,396 @@
+// Package notifying provides the use-cases for notifying customers of the
+// status changes of their cargos through webhooks.
+package notifying
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/Qalifah/shipping/cargo"
+)
+
+// ErrInvalidArgument is returned when one or more arguments are invalid
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrUnknownSubscription is used when a subscription can't be found
+var ErrUnknownSubscription = errors.New("unknown subscription")
+
+// ErrUnknownDelivery is used when a dead-lettered delivery can't be found
+var ErrUnknownDelivery = errors.New("unknown delivery")
+
+// SubscriptionID uniquely identifies a subscription
+type SubscriptionID string
+
+// EventType describes the status change of a cargo that subscribers are
+// notified of.
+type EventType string
+
+// valid event types
+const (
+	CargoHandled     EventType = "cargo_handled"
+	CargoMisdirected EventType = "cargo_misdirected"
+	CargoArrived     EventType = "cargo_arrived"
+	CargoDelayed     EventType = "cargo_delayed"
+)
+
+// Event is a status change of a cargo, as posted to subscribers.
+type Event struct {
+	Type         EventType `json:"type"`
+	TrackingID   string    `json:"tracking_id"`
+	Activity     string    `json:"activity,omitempty"`
+	Location     string    `json:"location,omitempty"`
+	VoyageNumber string    `json:"voyage_number,omitempty"`
+	Time         time.Time `json:"time"`
+}
+
+// delivery statuses
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusDead      = "dead"
+	StatusCancelled = "cancelled"
+)
+
+// Service is the interface that provides webhook subscriptions.
+type Service interface {
+	// Subscribe registers a callback URL to be notified of the status
+	// changes of the cargos with the given tracking IDs, of which there must
+	// be at least one. The returned subscription includes the secret that
+	// payloads are signed with, which is not shown again.
+	Subscribe(ids []cargo.TrackingID, callback string) (Subscription, error)
+
+	// Unsubscribe removes a subscription, and cancels its pending deliveries.
+	Unsubscribe(id SubscriptionID) error
+
+	// LoadSubscription returns a read model of a subscription.
+	LoadSubscription(id SubscriptionID) (Subscription, error)
+
+	// Subscriptions returns all subscriptions.
+	Subscriptions() []Subscription
+
+	// Deliveries returns the most recent deliveries to a subscription,
+	// oldest first.
+	Deliveries(id SubscriptionID) ([]Delivery, error)
+
+	// DeadLetters returns the deliveries that failed every attempt.
+	DeadLetters() []Delivery
+
+	// RetryDeadLetter takes a delivery off the dead-letter list and attempts
+	// it again.
+	RetryDeadLetter(id string) error
+
+	// Publish posts an event to every subscription that it concerns.
+	Publish(e Event)
+}
+
+const (
+	// maxDeliveries bounds the delivery log of each subscription.
+	maxDeliveries = 100
+
+	// maxDeadLetters bounds the dead-letter list.
+	maxDeadLetters = 1000
+
+	// maxBackoff bounds the wait between two attempts of a delivery.
+	maxBackoff = 10 * time.Minute
+)
+
+type subscription struct {
+	Subscription
+	deliveries []*Delivery
+}
+
+type service struct {
+	mtx           sync.Mutex
+	cargos        cargo.Repository
+	subscriptions map[SubscriptionID]*subscription
+	deadLetters   []*Delivery
+	client        *http.Client
+	policy        *callbackPolicy
+	attempts      int
+	backoff       time.Duration
+}
+
+func (s *service) Subscribe(ids []cargo.TrackingID, callback string) (Subscription, error) {
+	if len(ids) == 0 {
+		return Subscription{}, ErrInvalidArgument
+	}
+	u, err := url.Parse(callback)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return Subscription{}, ErrInvalidArgument
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+	defer cancel()
+	if _, err := s.policy.check(ctx, u.Hostname()); err == ErrCallbackNotAllowed {
+		return Subscription{}, err
+	} else if err != nil {
+		return Subscription{}, ErrInvalidArgument
+	}
+
+	seen := make(map[cargo.TrackingID]bool)
+	trackingIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			return Subscription{}, ErrInvalidArgument
+		}
+		if seen[id] {
+			continue
+		}
+		if _, err := s.cargos.Find(id); err != nil {
+			return Subscription{}, err
+		}
+		seen[id] = true
+		trackingIDs = append(trackingIDs, string(id))
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return Subscription{}, err
+	}
+
+	sub := &subscription{
+		Subscription: Subscription{
+			ID:          SubscriptionID(strings.ToUpper(uuid.New())),
+			TrackingIDs: trackingIDs,
+			URL:         callback,
+			Secret:      hex.EncodeToString(secret),
+			Created:     time.Now(),
+		},
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.subscriptions[sub.ID] = sub
+
+	return sub.Subscription, nil
+}
+
+func (s *service) Unsubscribe(id SubscriptionID) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	sub, ok := s.subscriptions[id]
+	if !ok {
+		return ErrUnknownSubscription
+	}
+	for _, d := range sub.deliveries {
+		if d.Status == StatusPending {
+			d.Status = StatusCancelled
+		}
+	}
+	delete(s.subscriptions, id)
+	return nil
+}
+
+func (s *service) LoadSubscription(id SubscriptionID) (Subscription, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	sub, ok := s.subscriptions[id]
+	if !ok {
+		return Subscription{}, ErrUnknownSubscription
+	}
+	return sub.withoutSecret(), nil
+}
+
+func (s *service) Subscriptions() []Subscription {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	result := make([]Subscription, 0, len(s.subscriptions))
+	for _, sub := range s.subscriptions {
+		result = append(result, sub.withoutSecret())
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Created.Before(result[j].Created) })
+	return result
+}
+
+func (s *service) Deliveries(id SubscriptionID) ([]Delivery, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	sub, ok := s.subscriptions[id]
+	if !ok {
+		return nil, ErrUnknownSubscription
+	}
+	result := make([]Delivery, 0, len(sub.deliveries))
+	for _, d := range sub.deliveries {
+		result = append(result, *d)
+	}
+	return result, nil
+}
+
+func (s *service) DeadLetters() []Delivery {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	result := make([]Delivery, 0, len(s.deadLetters))
+	for _, d := range s.deadLetters {
+		result = append(result, *d)
+	}
+	return result
+}
+
+func (s *service) RetryDeadLetter(id string) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for i, d := range s.deadLetters {
+		if d.ID != id {
+			continue
+		}
+		if _, ok := s.subscriptions[d.SubscriptionID]; !ok {
+			return ErrUnknownSubscription
+		}
+		s.deadLetters = append(s.deadLetters[:i], s.deadLetters[i+1:]...)
+		d.Status = StatusPending
+		d.Attempts = 0
+		go s.attempt(d)
+		return nil
+	}
+	return ErrUnknownDelivery
+}
+
+func (s *service) Publish(e Event) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, sub := range s.subscriptions {
+		if !sub.concerns(e.TrackingID) {
+			continue
+		}
+		d := newDelivery(sub.ID, e)
+		sub.deliveries = append(sub.deliveries, d)
+		if len(sub.deliveries) > maxDeliveries {
+			sub.deliveries = sub.deliveries[len(sub.deliveries)-maxDeliveries:]
+		}
+		go s.attempt(d)
+	}
+}
+
+// attempt posts a delivery to its subscription, and schedules the next
+// attempt with exponential backoff if it fails. A delivery that fails every
+// attempt is dead-lettered.
+func (s *service) attempt(d *Delivery) {
+	s.mtx.Lock()
+	sub, ok := s.subscriptions[d.SubscriptionID]
+	if !ok || d.Status != StatusPending {
+		if d.Status == StatusPending {
+			d.Status = StatusCancelled
+		}
+		s.mtx.Unlock()
+		return
+	}
+	callback, secret := sub.URL, sub.Secret
+	s.mtx.Unlock()
+
+	code, err := post(s.client, callback, secret, d)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	d.Attempts++
+	d.LastAttempt = time.Now()
+	d.LastStatusCode = code
+	d.LastError = ""
+	if err != nil {
+		d.LastError = err.Error()
+	}
+
+	switch {
+	case d.Status != StatusPending:
+		// Cancelled while the attempt was in flight.
+	case err == nil:
+		d.Status = StatusDelivered
+	case d.Attempts >= s.attempts:
+		d.Status = StatusDead
+		s.deadLetters = append(s.deadLetters, d)
+		if len(s.deadLetters) > maxDeadLetters {
+			s.deadLetters = s.deadLetters[len(s.deadLetters)-maxDeadLetters:]
+		}
+	default:
+		time.AfterFunc(s.wait(d.Attempts), func() { s.attempt(d) })
+	}
+}
+
+// wait returns how long to wait after the given number of failed attempts.
+func (s *service) wait(attempts int) time.Duration {
+	wait := s.backoff
+	for i := 1; i < attempts && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+	return wait
+}
+
+// NewService creates a notifying service with necessary dependencies. Each
+// delivery is attempted up to the given number of times, waiting the backoff
+// after the first failure, and twice as long after each further one.
+// Callback URLs must resolve to public addresses, unless their host is one
+// of the allowed hosts. If client is nil, a client with a short timeout that
+// enforces the same when connecting is used.
+func NewService(cargos cargo.Repository, client *http.Client, attempts int, backoff time.Duration, allowedHosts []string) Service {
+	policy := newCallbackPolicy(allowedHosts)
+	if client == nil {
+		client = policy.client()
+	}
+	if attempts < 1 {
+		attempts = 1
+	}
+	return &service{
+		cargos:        cargos,
+		subscriptions: make(map[SubscriptionID]*subscription),
+		client:        client,
+		policy:        policy,
+		attempts:      attempts,
+		backoff:       backoff,
+	}
+}
+
+// Subscription is a read model for subscription views.
+type Subscription struct {
+	ID          SubscriptionID `json:"id"`
+	TrackingIDs []string       `json:"tracking_ids"`
+	URL         string         `json:"url"`
+	Secret      string         `json:"secret,omitempty"`
+	Created     time.Time      `json:"created"`
+}
+
+func (s *subscription) concerns(id string) bool {
+	for _, t := range s.TrackingIDs {
+		if t == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *subscription) withoutSecret() Subscription {
+	result := s.Subscription
+	result.Secret = ""
+	return result
+}
+
+// Delivery is a read model for the delivery log.
+type Delivery struct {
+	ID             string         `json:"id"`
+	SubscriptionID SubscriptionID `json:"subscription_id"`
+	Event          Event          `json:"event"`
+	Status         string         `json:"status"`
+	Attempts       int            `json:"attempts"`
+	LastAttempt    time.Time      `json:"last_attempt"`
+	LastStatusCode int            `json:"last_status_code,omitempty"`
+	LastError      string         `json:"last_error,omitempty"`
+	Created        time.Time      `json:"created"`
+}
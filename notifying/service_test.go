@@ -0,0 +1,244 @@
+package notifying
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/inmem"
+	"github.com/Qalifah/shipping/location"
+)
+
+// subscriber is a callback that fails while failures is positive, counting
+// down at each request.
+type subscriber struct {
+	*httptest.Server
+	failures int32
+	requests int32
+	last     atomic.Value // *http.Request with its body read into lastBody
+	lastBody atomic.Value // []byte
+}
+
+func newSubscriber(t *testing.T, failures int32) *subscriber {
+	s := &subscriber{failures: failures}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.requests, 1)
+		body, _ := ioutil.ReadAll(r.Body)
+		s.lastBody.Store(body)
+		s.last.Store(r)
+		if atomic.AddInt32(&s.failures, -1) >= 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func newTestService(t *testing.T, attempts int) Service {
+	cargos := inmem.NewCargoRepository()
+	if err := cargos.Store(cargo.New("ABC123", cargo.RouteSpecification{
+		Origin:      location.SESTO,
+		Destination: location.CNHKG,
+		Deadline:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	})); err != nil {
+		t.Fatal(err)
+	}
+	return NewService(cargos, nil, attempts, time.Millisecond, []string{"127.0.0.1"})
+}
+
+// settled waits until the only delivery to a subscription is no longer
+// pending, and returns it.
+func settled(t *testing.T, s Service, id SubscriptionID) Delivery {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		ds, err := s.Deliveries(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ds) != 1 {
+			t.Fatalf("got %d deliveries, want 1", len(ds))
+		}
+		if ds[0].Status != StatusPending {
+			return ds[0]
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("delivery still pending after %d attempts", ds[0].Attempts)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+var handled = Event{Type: CargoHandled, TrackingID: "ABC123", Activity: "Load", Location: "SESTO", VoyageNumber: "V100"}
+
+func TestSubscribe(t *testing.T) {
+	s := newTestService(t, 1)
+
+	for _, tt := range []struct {
+		ids      []cargo.TrackingID
+		callback string
+		err      error
+	}{
+		{ids: nil, callback: "http://127.0.0.1/hook", err: ErrInvalidArgument},
+		{ids: []cargo.TrackingID{"ABC123"}, callback: "ftp://127.0.0.1/hook", err: ErrInvalidArgument},
+		{ids: []cargo.TrackingID{"ABC123"}, callback: "http:///hook", err: ErrInvalidArgument},
+		{ids: []cargo.TrackingID{"ABC123"}, callback: "http://10.0.0.1/hook", err: ErrCallbackNotAllowed},
+		{ids: []cargo.TrackingID{"ABC123"}, callback: "http://[::1]:8080/hook", err: ErrCallbackNotAllowed},
+		{ids: []cargo.TrackingID{"NOPE"}, callback: "http://127.0.0.1/hook", err: cargo.ErrUnknown},
+		{ids: []cargo.TrackingID{"ABC123", "ABC123"}, callback: "http://127.0.0.1/hook"},
+	} {
+		sub, err := s.Subscribe(tt.ids, tt.callback)
+		if err != tt.err {
+			t.Errorf("%v %s: got %v, want %v", tt.ids, tt.callback, err, tt.err)
+			continue
+		}
+		if err == nil && (len(sub.TrackingIDs) != 1 || len(sub.Secret) != 64) {
+			t.Errorf("got %+v, want one tracking ID and a secret", sub)
+		}
+	}
+}
+
+func TestDeliveryIsSigned(t *testing.T) {
+	s := newTestService(t, 1)
+	cb := newSubscriber(t, 0)
+
+	sub, err := s.Subscribe([]cargo.TrackingID{"ABC123"}, cb.URL+"/hook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Publish(handled)
+
+	d := settled(t, s, sub.ID)
+	if d.Status != StatusDelivered {
+		t.Fatalf("got %s, want %s", d.Status, StatusDelivered)
+	}
+
+	r := cb.last.Load().(*http.Request)
+	body := cb.lastBody.Load().([]byte)
+	if got := r.Header.Get(HeaderDelivery); got != d.ID {
+		t.Errorf("delivery header: got %q, want %q", got, d.ID)
+	}
+	signature := r.Header.Get(HeaderSignature)
+	if !strings.HasPrefix(signature, "sha256=") {
+		t.Fatalf("signature header: got %q, want it prefixed with sha256=", signature)
+	}
+	if want := Sign(sub.Secret, r.Header.Get(HeaderTimestamp), body); signature[len("sha256="):] != want {
+		t.Errorf("signature header: got %q, want sha256=%s", signature, want)
+	}
+
+	var p payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		t.Fatal(err)
+	}
+	if p.DeliveryID != d.ID || p.SubscriptionID != sub.ID || p.Type != CargoHandled || p.VoyageNumber != "V100" {
+		t.Errorf("got payload %+v", p)
+	}
+}
+
+func TestDeliveryRetries(t *testing.T) {
+	s := newTestService(t, 3)
+	cb := newSubscriber(t, 2)
+
+	sub, err := s.Subscribe([]cargo.TrackingID{"ABC123"}, cb.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Publish(handled)
+	s.Publish(Event{Type: CargoHandled, TrackingID: "FTL456"})
+
+	d := settled(t, s, sub.ID)
+	if d.Status != StatusDelivered || d.Attempts != 3 || d.LastStatusCode != http.StatusNoContent || d.LastError != "" {
+		t.Errorf("got %+v, want delivered at the third attempt", d)
+	}
+	if n := len(s.DeadLetters()); n != 0 {
+		t.Errorf("got %d dead letters, want none", n)
+	}
+}
+
+func TestDeliveryIsDeadLettered(t *testing.T) {
+	s := newTestService(t, 3)
+	cb := newSubscriber(t, 3)
+
+	sub, err := s.Subscribe([]cargo.TrackingID{"ABC123"}, cb.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Publish(handled)
+
+	d := settled(t, s, sub.ID)
+	if d.Status != StatusDead || d.Attempts != 3 || d.LastStatusCode != http.StatusInternalServerError || d.LastError == "" {
+		t.Fatalf("got %+v, want dead after three attempts", d)
+	}
+	dead := s.DeadLetters()
+	if len(dead) != 1 || dead[0].ID != d.ID {
+		t.Fatalf("got dead letters %+v, want %s", dead, d.ID)
+	}
+	if n := atomic.LoadInt32(&cb.requests); n != 3 {
+		t.Errorf("got %d requests, want 3", n)
+	}
+
+	if err := s.RetryDeadLetter("NOPE"); err != ErrUnknownDelivery {
+		t.Errorf("retrying an unknown delivery: got %v, want %v", err, ErrUnknownDelivery)
+	}
+
+	// The subscriber has recovered by now.
+	if err := s.RetryDeadLetter(d.ID); err != nil {
+		t.Fatal(err)
+	}
+	d = settled(t, s, sub.ID)
+	if d.Status != StatusDelivered || d.Attempts != 1 {
+		t.Errorf("got %+v, want delivered at the first attempt", d)
+	}
+	if n := len(s.DeadLetters()); n != 0 {
+		t.Errorf("got %d dead letters, want none", n)
+	}
+	if err := s.RetryDeadLetter(d.ID); err != ErrUnknownDelivery {
+		t.Errorf("retrying a delivered delivery: got %v, want %v", err, ErrUnknownDelivery)
+	}
+}
+
+func TestRetryDeadLetterOfUnsubscribed(t *testing.T) {
+	s := newTestService(t, 1)
+	cb := newSubscriber(t, 1)
+
+	sub, err := s.Subscribe([]cargo.TrackingID{"ABC123"}, cb.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Publish(handled)
+	d := settled(t, s, sub.ID)
+
+	if err := s.Unsubscribe(sub.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RetryDeadLetter(d.ID); err != ErrUnknownSubscription {
+		t.Errorf("got %v, want %v", err, ErrUnknownSubscription)
+	}
+}
+
+func TestWait(t *testing.T) {
+	s := &service{backoff: time.Second}
+	for _, tt := range []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{3, 4 * time.Second},
+		{10, 512 * time.Second},
+		{11, maxBackoff},
+		{100, maxBackoff},
+	} {
+		if got := s.wait(tt.attempts); got != tt.want {
+			t.Errorf("after %d attempts: got %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
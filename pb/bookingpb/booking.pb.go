@@ -35,13 +35,17 @@ type Cargo struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ArrivalDeadline *timestamp.Timestamp `protobuf:"bytes,1,opt,name=arrival_deadline,json=arrivalDeadline,proto3" json:"arrival_deadline,omitempty"`
-	Destination     string               `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
-	Legs            []*Leg               `protobuf:"bytes,3,rep,name=legs,proto3" json:"legs,omitempty"`
-	Misrouted       bool                 `protobuf:"varint,4,opt,name=misrouted,proto3" json:"misrouted,omitempty"`
-	Origin          string               `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
-	Routed          bool                 `protobuf:"varint,6,opt,name=routed,proto3" json:"routed,omitempty"`
-	TrackingId      string               `protobuf:"bytes,7,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	ArrivalDeadline  *timestamp.Timestamp `protobuf:"bytes,1,opt,name=arrival_deadline,json=arrivalDeadline,proto3" json:"arrival_deadline,omitempty"`
+	Destination      string               `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Legs             []*Leg               `protobuf:"bytes,3,rep,name=legs,proto3" json:"legs,omitempty"`
+	Misrouted        bool                 `protobuf:"varint,4,opt,name=misrouted,proto3" json:"misrouted,omitempty"`
+	Origin           string               `protobuf:"bytes,5,opt,name=origin,proto3" json:"origin,omitempty"`
+	Routed           bool                 `protobuf:"varint,6,opt,name=routed,proto3" json:"routed,omitempty"`
+	TrackingId       string               `protobuf:"bytes,7,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	MissedConnection bool                 `protobuf:"varint,8,opt,name=missed_connection,json=missedConnection,proto3" json:"missed_connection,omitempty"`
+	Late             bool                 `protobuf:"varint,9,opt,name=late,proto3" json:"late,omitempty"`
+	// distance is the total great-circle distance of the legs in kilometres.
+	Distance float64 `protobuf:"fixed64,10,opt,name=distance,proto3" json:"distance,omitempty"`
 }
 
 func (x *Cargo) Reset() {
@@ -125,6 +129,27 @@ func (x *Cargo) GetTrackingId() string {
 	return ""
 }
 
+func (x *Cargo) GetMissedConnection() bool {
+	if x != nil {
+		return x.MissedConnection
+	}
+	return false
+}
+
+func (x *Cargo) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+func (x *Cargo) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
 type Leg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -532,6 +557,10 @@ type RoutesForCargoRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	TrackingId string `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	// rank lists the criteria to rank routes by: arrival, transshipments
+	// or transit.
+	Rank        []string          `protobuf:"bytes,2,rep,name=rank,proto3" json:"rank,omitempty"`
+	Constraints *RouteConstraints `protobuf:"bytes,3,opt,name=constraints,proto3" json:"constraints,omitempty"`
 }
 
 func (x *RoutesForCargoRequest) Reset() {
@@ -573,18 +602,112 @@ func (x *RoutesForCargoRequest) GetTrackingId() string {
 	return ""
 }
 
+func (x *RoutesForCargoRequest) GetRank() []string {
+	if x != nil {
+		return x.Rank
+	}
+	return nil
+}
+
+func (x *RoutesForCargoRequest) GetConstraints() *RouteConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+type RouteConstraints struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExcludedLocations    []string `protobuf:"bytes,1,rep,name=excluded_locations,json=excludedLocations,proto3" json:"excluded_locations,omitempty"`
+	AllowedVoyages       []string `protobuf:"bytes,2,rep,name=allowed_voyages,json=allowedVoyages,proto3" json:"allowed_voyages,omitempty"`
+	ForbiddenVoyages     []string `protobuf:"bytes,3,rep,name=forbidden_voyages,json=forbiddenVoyages,proto3" json:"forbidden_voyages,omitempty"`
+	MaxLegs              int32    `protobuf:"varint,4,opt,name=max_legs,json=maxLegs,proto3" json:"max_legs,omitempty"`
+	MinConnectionSeconds int64    `protobuf:"varint,5,opt,name=min_connection_seconds,json=minConnectionSeconds,proto3" json:"min_connection_seconds,omitempty"`
+}
+
+func (x *RouteConstraints) Reset() {
+	*x = RouteConstraints{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_booking_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteConstraints) ProtoMessage() {}
+
+func (x *RouteConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_booking_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteConstraints.ProtoReflect.Descriptor instead.
+func (*RouteConstraints) Descriptor() ([]byte, []int) {
+	return file_booking_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RouteConstraints) GetExcludedLocations() []string {
+	if x != nil {
+		return x.ExcludedLocations
+	}
+	return nil
+}
+
+func (x *RouteConstraints) GetAllowedVoyages() []string {
+	if x != nil {
+		return x.AllowedVoyages
+	}
+	return nil
+}
+
+func (x *RouteConstraints) GetForbiddenVoyages() []string {
+	if x != nil {
+		return x.ForbiddenVoyages
+	}
+	return nil
+}
+
+func (x *RouteConstraints) GetMaxLegs() int32 {
+	if x != nil {
+		return x.MaxLegs
+	}
+	return 0
+}
+
+func (x *RouteConstraints) GetMinConnectionSeconds() int64 {
+	if x != nil {
+		return x.MinConnectionSeconds
+	}
+	return 0
+}
+
 type RoutesForCargoReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Itineraries []*Itinerary `protobuf:"bytes,1,rep,name=itineraries,proto3" json:"itineraries,omitempty"`
+	Err         string       `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
 }
 
 func (x *RoutesForCargoReply) Reset() {
 	*x = RoutesForCargoReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_booking_proto_msgTypes[9]
+		mi := &file_booking_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -597,7 +720,7 @@ func (x *RoutesForCargoReply) String() string {
 func (*RoutesForCargoReply) ProtoMessage() {}
 
 func (x *RoutesForCargoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[9]
+	mi := &file_booking_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +733,7 @@ func (x *RoutesForCargoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RoutesForCargoReply.ProtoReflect.Descriptor instead.
 func (*RoutesForCargoReply) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{9}
+	return file_booking_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RoutesForCargoReply) GetItineraries() []*Itinerary {
@@ -620,6 +743,13 @@ func (x *RoutesForCargoReply) GetItineraries() []*Itinerary {
 	return nil
 }
 
+func (x *RoutesForCargoReply) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
 type CargoToRouteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -632,7 +762,7 @@ type CargoToRouteRequest struct {
 func (x *CargoToRouteRequest) Reset() {
 	*x = CargoToRouteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_booking_proto_msgTypes[10]
+		mi := &file_booking_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -645,7 +775,7 @@ func (x *CargoToRouteRequest) String() string {
 func (*CargoToRouteRequest) ProtoMessage() {}
 
 func (x *CargoToRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[10]
+	mi := &file_booking_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +788,7 @@ func (x *CargoToRouteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CargoToRouteRequest.ProtoReflect.Descriptor instead.
 func (*CargoToRouteRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{10}
+	return file_booking_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CargoToRouteRequest) GetTrackingId() string {
@@ -686,7 +816,7 @@ type CargoToRouteReply struct {
 func (x *CargoToRouteReply) Reset() {
 	*x = CargoToRouteReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_booking_proto_msgTypes[11]
+		mi := &file_booking_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -699,7 +829,7 @@ func (x *CargoToRouteReply) String() string {
 func (*CargoToRouteReply) ProtoMessage() {}
 
 func (x *CargoToRouteReply) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[11]
+	mi := &file_booking_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +842,7 @@ func (x *CargoToRouteReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CargoToRouteReply.ProtoReflect.Descriptor instead.
 func (*CargoToRouteReply) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{11}
+	return file_booking_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CargoToRouteReply) GetErr() string {
@@ -734,7 +864,7 @@ type ChangeDestinationRequest struct {
 func (x *ChangeDestinationRequest) Reset() {
 	*x = ChangeDestinationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_booking_proto_msgTypes[12]
+		mi := &file_booking_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -747,7 +877,7 @@ func (x *ChangeDestinationRequest) String() string {
 func (*ChangeDestinationRequest) ProtoMessage() {}
 
 func (x *ChangeDestinationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[12]
+	mi := &file_booking_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -760,7 +890,7 @@ func (x *ChangeDestinationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeDestinationRequest.ProtoReflect.Descriptor instead.
 func (*ChangeDestinationRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{12}
+	return file_booking_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ChangeDestinationRequest) GetTrackingId() string {
@@ -788,7 +918,7 @@ type ChangeDestinationReply struct {
 func (x *ChangeDestinationReply) Reset() {
 	*x = ChangeDestinationReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_booking_proto_msgTypes[13]
+		mi := &file_booking_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -801,7 +931,7 @@ func (x *ChangeDestinationReply) String() string {
 func (*ChangeDestinationReply) ProtoMessage() {}
 
 func (x *ChangeDestinationReply) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[13]
+	mi := &file_booking_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -814,7 +944,7 @@ func (x *ChangeDestinationReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeDestinationReply.ProtoReflect.Descriptor instead.
 func (*ChangeDestinationReply) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{13}
+	return file_booking_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ChangeDestinationReply) GetErr() string {
@@ -833,7 +963,7 @@ type CargosRequest struct {
 func (x *CargosRequest) Reset() {
 	*x = CargosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_booking_proto_msgTypes[14]
+		mi := &file_booking_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -846,7 +976,7 @@ func (x *CargosRequest) String() string {
 func (*CargosRequest) ProtoMessage() {}
 
 func (x *CargosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[14]
+	mi := &file_booking_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -859,7 +989,7 @@ func (x *CargosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CargosRequest.ProtoReflect.Descriptor instead.
 func (*CargosRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{14}
+	return file_booking_proto_rawDescGZIP(), []int{15}
 }
 
 type CargosReply struct {
@@ -873,7 +1003,7 @@ type CargosReply struct {
 func (x *CargosReply) Reset() {
 	*x = CargosReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_booking_proto_msgTypes[15]
+		mi := &file_booking_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -886,7 +1016,7 @@ func (x *CargosReply) String() string {
 func (*CargosReply) ProtoMessage() {}
 
 func (x *CargosReply) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[15]
+	mi := &file_booking_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1029,7 @@ func (x *CargosReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CargosReply.ProtoReflect.Descriptor instead.
 func (*CargosReply) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{15}
+	return file_booking_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CargosReply) GetCargos() []*Cargo {
@@ -918,7 +1048,7 @@ type LocationsRequest struct {
 func (x *LocationsRequest) Reset() {
 	*x = LocationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_booking_proto_msgTypes[16]
+		mi := &file_booking_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -931,7 +1061,7 @@ func (x *LocationsRequest) String() string {
 func (*LocationsRequest) ProtoMessage() {}
 
 func (x *LocationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[16]
+	mi := &file_booking_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -944,7 +1074,7 @@ func (x *LocationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocationsRequest.ProtoReflect.Descriptor instead.
 func (*LocationsRequest) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{16}
+	return file_booking_proto_rawDescGZIP(), []int{17}
 }
 
 type LocationsReply struct {
@@ -958,7 +1088,7 @@ type LocationsReply struct {
 func (x *LocationsReply) Reset() {
 	*x = LocationsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_booking_proto_msgTypes[17]
+		mi := &file_booking_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -971,7 +1101,7 @@ func (x *LocationsReply) String() string {
 func (*LocationsReply) ProtoMessage() {}
 
 func (x *LocationsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_booking_proto_msgTypes[17]
+	mi := &file_booking_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,7 +1114,7 @@ func (x *LocationsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocationsReply.ProtoReflect.Descriptor instead.
 func (*LocationsReply) Descriptor() ([]byte, []int) {
-	return file_booking_proto_rawDescGZIP(), []int{17}
+	return file_booking_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *LocationsReply) GetLocations() []*Location {
@@ -998,141 +1128,168 @@ var File_booking_proto protoreflect.FileDescriptor
 
 var file_booking_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x02, 0x0a, 0x05, 0x43, 0x61,
-	0x72, 0x67, 0x6f, 0x12, 0x45, 0x0a, 0x10, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x61, 0x72, 0x72, 0x69, 0x76,
-	0x61, 0x6c, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x04,
-	0x6c, 0x65, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x1c,
-	0x0a, 0x09, 0x6d, 0x69, 0x73, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x6d, 0x69, 0x73, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0xee, 0x01,
-	0x0a, 0x03, 0x4c, 0x65, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x5f,
-	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x6f,
-	0x79, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f,
-	0x61, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x6f, 0x61, 0x64,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x38,
-	0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e,
-	0x6c, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x6e, 0x6c,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x09, 0x49, 0x74, 0x69, 0x6e,
-	0x65, 0x72, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x65,
-	0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x43,
-	0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69,
-	0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x02, 0x0a, 0x05,
+	0x43, 0x61, 0x72, 0x67, 0x6f, 0x12, 0x45, 0x0a, 0x10, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x61, 0x72, 0x72,
+	0x69, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65,
+	0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x73, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x69, 0x73, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74,
+	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xee,
+	0x01, 0x0a, 0x03, 0x4c, 0x65, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76,
+	0x6f, 0x79, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x42, 0x0a,
-	0x0d, 0x4e, 0x65, 0x77, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1f,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x75, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x38, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x6e, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x6e,
+	0x6c, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2f, 0x0a, 0x09, 0x49, 0x74, 0x69,
+	0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x12, 0x22, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62,
+	0x2e, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x4e,
+	0x65, 0x77, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x22, 0x42, 0x0a, 0x0d, 0x4e, 0x65, 0x77, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x65, 0x72, 0x72, 0x22, 0x33, 0x0a, 0x10, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x72, 0x67,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x0e, 0x4c, 0x6f, 0x61,
+	0x64, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x63,
+	0x61, 0x72, 0x67, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x05, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x15, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x46, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x74, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x10, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x76, 0x6f,
+	0x79, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6f, 0x72,
+	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x5f,
+	0x0a, 0x13, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x67, 0x6f,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x36, 0x0a, 0x0b, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79,
+	0x52, 0x0b, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22,
+	0x6a, 0x0a, 0x13, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x09, 0x69, 0x74, 0x69, 0x6e, 0x65,
+	0x72, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x49, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79,
+	0x52, 0x09, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x22, 0x25, 0x0a, 0x11, 0x43,
+	0x61, 0x72, 0x67, 0x6f, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65,
+	0x72, 0x72, 0x22, 0x5d, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
 	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
-	0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72,
-	0x72, 0x22, 0x33, 0x0a, 0x10, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
-	0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x0e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x61,
-	0x72, 0x67, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x67,
-	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x05, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x12, 0x10,
-	0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72,
-	0x22, 0x38, 0x0a, 0x15, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x61, 0x72,
-	0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x13, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x34, 0x0a, 0x0b, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x49, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x52, 0x0b, 0x69, 0x74, 0x69, 0x6e,
-	0x65, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0x68, 0x0a, 0x13, 0x43, 0x61, 0x72, 0x67, 0x6f,
-	0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12,
-	0x30, 0x0a, 0x09, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x49, 0x74, 0x69,
-	0x6e, 0x65, 0x72, 0x61, 0x72, 0x79, 0x52, 0x09, 0x69, 0x74, 0x69, 0x6e, 0x65, 0x72, 0x61, 0x72,
-	0x79, 0x22, 0x25, 0x0a, 0x11, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x5d, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x65, 0x72, 0x72, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61,
-	0x72, 0x67, 0x6f, 0x52, 0x06, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x41, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x2f, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x32, 0x9b, 0x04, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x42,
-	0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6b, 0x4e, 0x65, 0x77, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x12, 0x18,
-	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x61, 0x72, 0x67,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
-	0x6e, 0x67, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x41, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x12,
-	0x19, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x61,
-	0x72, 0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x1d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x50, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x46, 0x6f,
-	0x72, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x2a, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x0f, 0x0a,
+	0x0d, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37,
+	0x0a, 0x0b, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a,
+	0x06, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52,
+	0x06, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x0e, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31, 0x0a,
+	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x32, 0xb7, 0x04, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x46, 0x0a, 0x0c,
+	0x42, 0x6f, 0x6f, 0x6b, 0x4e, 0x65, 0x77, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x12, 0x1a, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x61, 0x72, 0x67,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69,
+	0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x77, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x09, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x61, 0x72, 0x67,
+	0x6f, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
+	0x61, 0x64, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x43,
+	0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x1d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x12, 0x20, 0x2e, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x46,
+	0x6f, 0x72, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x46, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x54, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x54,
+	0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x12, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x43, 0x61, 0x72, 0x67, 0x6f, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1c, 0x2e, 0x62,
-	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x54, 0x6f, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e,
-	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65,
+	0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x54, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x62, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65,
 	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x06, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x12, 0x16, 0x2e,
-	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
-	0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a,
-	0x09, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x62, 0x6f, 0x6f,
-	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
-	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x51,
-	0x61, 0x6c, 0x69, 0x66, 0x61, 0x68, 0x2f, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2f,
-	0x70, 0x62, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x06, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x12,
+	0x18, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x67,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6f, 0x6f, 0x6b,
+	0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1147,59 +1304,61 @@ func file_booking_proto_rawDescGZIP() []byte {
 	return file_booking_proto_rawDescData
 }
 
-var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_booking_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_booking_proto_goTypes = []interface{}{
-	(*Cargo)(nil),                    // 0: booking.Cargo
-	(*Leg)(nil),                      // 1: booking.Leg
-	(*Location)(nil),                 // 2: booking.Location
-	(*Itinerary)(nil),                // 3: booking.Itinerary
-	(*NewCargoRequest)(nil),          // 4: booking.NewCargoRequest
-	(*NewCargoReply)(nil),            // 5: booking.NewCargoReply
-	(*LoadCargoRequest)(nil),         // 6: booking.LoadCargoRequest
-	(*LoadCargoReply)(nil),           // 7: booking.LoadCargoReply
-	(*RoutesForCargoRequest)(nil),    // 8: booking.RoutesForCargoRequest
-	(*RoutesForCargoReply)(nil),      // 9: booking.RoutesForCargoReply
-	(*CargoToRouteRequest)(nil),      // 10: booking.CargoToRouteRequest
-	(*CargoToRouteReply)(nil),        // 11: booking.CargoToRouteReply
-	(*ChangeDestinationRequest)(nil), // 12: booking.ChangeDestinationRequest
-	(*ChangeDestinationReply)(nil),   // 13: booking.ChangeDestinationReply
-	(*CargosRequest)(nil),            // 14: booking.CargosRequest
-	(*CargosReply)(nil),              // 15: booking.CargosReply
-	(*LocationsRequest)(nil),         // 16: booking.LocationsRequest
-	(*LocationsReply)(nil),           // 17: booking.LocationsReply
-	(*timestamp.Timestamp)(nil),      // 18: google.protobuf.Timestamp
+	(*Cargo)(nil),                    // 0: bookingpb.Cargo
+	(*Leg)(nil),                      // 1: bookingpb.Leg
+	(*Location)(nil),                 // 2: bookingpb.Location
+	(*Itinerary)(nil),                // 3: bookingpb.Itinerary
+	(*NewCargoRequest)(nil),          // 4: bookingpb.NewCargoRequest
+	(*NewCargoReply)(nil),            // 5: bookingpb.NewCargoReply
+	(*LoadCargoRequest)(nil),         // 6: bookingpb.LoadCargoRequest
+	(*LoadCargoReply)(nil),           // 7: bookingpb.LoadCargoReply
+	(*RoutesForCargoRequest)(nil),    // 8: bookingpb.RoutesForCargoRequest
+	(*RouteConstraints)(nil),         // 9: bookingpb.RouteConstraints
+	(*RoutesForCargoReply)(nil),      // 10: bookingpb.RoutesForCargoReply
+	(*CargoToRouteRequest)(nil),      // 11: bookingpb.CargoToRouteRequest
+	(*CargoToRouteReply)(nil),        // 12: bookingpb.CargoToRouteReply
+	(*ChangeDestinationRequest)(nil), // 13: bookingpb.ChangeDestinationRequest
+	(*ChangeDestinationReply)(nil),   // 14: bookingpb.ChangeDestinationReply
+	(*CargosRequest)(nil),            // 15: bookingpb.CargosRequest
+	(*CargosReply)(nil),              // 16: bookingpb.CargosReply
+	(*LocationsRequest)(nil),         // 17: bookingpb.LocationsRequest
+	(*LocationsReply)(nil),           // 18: bookingpb.LocationsReply
+	(*timestamp.Timestamp)(nil),      // 19: google.protobuf.Timestamp
 }
 var file_booking_proto_depIdxs = []int32{
-	18, // 0: booking.Cargo.arrival_deadline:type_name -> google.protobuf.Timestamp
-	1,  // 1: booking.Cargo.legs:type_name -> booking.Leg
-	18, // 2: booking.Leg.load_time:type_name -> google.protobuf.Timestamp
-	18, // 3: booking.Leg.unload_time:type_name -> google.protobuf.Timestamp
-	1,  // 4: booking.Itinerary.legs:type_name -> booking.Leg
-	18, // 5: booking.NewCargoRequest.deadline:type_name -> google.protobuf.Timestamp
-	0,  // 6: booking.LoadCargoReply.cargo:type_name -> booking.Cargo
-	3,  // 7: booking.RoutesForCargoReply.itineraries:type_name -> booking.Itinerary
-	3,  // 8: booking.CargoToRouteRequest.itinerary:type_name -> booking.Itinerary
-	0,  // 9: booking.CargosReply.cargos:type_name -> booking.Cargo
-	2,  // 10: booking.LocationsReply.locations:type_name -> booking.Location
-	4,  // 11: booking.Booking.BookNewCargo:input_type -> booking.NewCargoRequest
-	6,  // 12: booking.Booking.LoadCargo:input_type -> booking.LoadCargoRequest
-	8,  // 13: booking.Booking.RequestPossibleRoutesForCargo:input_type -> booking.RoutesForCargoRequest
-	10, // 14: booking.Booking.AssignCargoToRoute:input_type -> booking.CargoToRouteRequest
-	12, // 15: booking.Booking.ChangeDestination:input_type -> booking.ChangeDestinationRequest
-	14, // 16: booking.Booking.Cargos:input_type -> booking.CargosRequest
-	16, // 17: booking.Booking.Locations:input_type -> booking.LocationsRequest
-	5,  // 18: booking.Booking.BookNewCargo:output_type -> booking.NewCargoReply
-	7,  // 19: booking.Booking.LoadCargo:output_type -> booking.LoadCargoReply
-	9,  // 20: booking.Booking.RequestPossibleRoutesForCargo:output_type -> booking.RoutesForCargoReply
-	11, // 21: booking.Booking.AssignCargoToRoute:output_type -> booking.CargoToRouteReply
-	13, // 22: booking.Booking.ChangeDestination:output_type -> booking.ChangeDestinationReply
-	15, // 23: booking.Booking.Cargos:output_type -> booking.CargosReply
-	17, // 24: booking.Booking.Locations:output_type -> booking.LocationsReply
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	19, // 0: bookingpb.Cargo.arrival_deadline:type_name -> google.protobuf.Timestamp
+	1,  // 1: bookingpb.Cargo.legs:type_name -> bookingpb.Leg
+	19, // 2: bookingpb.Leg.load_time:type_name -> google.protobuf.Timestamp
+	19, // 3: bookingpb.Leg.unload_time:type_name -> google.protobuf.Timestamp
+	1,  // 4: bookingpb.Itinerary.legs:type_name -> bookingpb.Leg
+	19, // 5: bookingpb.NewCargoRequest.deadline:type_name -> google.protobuf.Timestamp
+	0,  // 6: bookingpb.LoadCargoReply.cargo:type_name -> bookingpb.Cargo
+	9,  // 7: bookingpb.RoutesForCargoRequest.constraints:type_name -> bookingpb.RouteConstraints
+	3,  // 8: bookingpb.RoutesForCargoReply.itineraries:type_name -> bookingpb.Itinerary
+	3,  // 9: bookingpb.CargoToRouteRequest.itinerary:type_name -> bookingpb.Itinerary
+	0,  // 10: bookingpb.CargosReply.cargos:type_name -> bookingpb.Cargo
+	2,  // 11: bookingpb.LocationsReply.locations:type_name -> bookingpb.Location
+	4,  // 12: bookingpb.Booking.BookNewCargo:input_type -> bookingpb.NewCargoRequest
+	6,  // 13: bookingpb.Booking.LoadCargo:input_type -> bookingpb.LoadCargoRequest
+	8,  // 14: bookingpb.Booking.RequestPossibleRoutesForCargo:input_type -> bookingpb.RoutesForCargoRequest
+	11, // 15: bookingpb.Booking.AssignCargoToRoute:input_type -> bookingpb.CargoToRouteRequest
+	13, // 16: bookingpb.Booking.ChangeDestination:input_type -> bookingpb.ChangeDestinationRequest
+	15, // 17: bookingpb.Booking.Cargos:input_type -> bookingpb.CargosRequest
+	17, // 18: bookingpb.Booking.Locations:input_type -> bookingpb.LocationsRequest
+	5,  // 19: bookingpb.Booking.BookNewCargo:output_type -> bookingpb.NewCargoReply
+	7,  // 20: bookingpb.Booking.LoadCargo:output_type -> bookingpb.LoadCargoReply
+	10, // 21: bookingpb.Booking.RequestPossibleRoutesForCargo:output_type -> bookingpb.RoutesForCargoReply
+	12, // 22: bookingpb.Booking.AssignCargoToRoute:output_type -> bookingpb.CargoToRouteReply
+	14, // 23: bookingpb.Booking.ChangeDestination:output_type -> bookingpb.ChangeDestinationReply
+	16, // 24: bookingpb.Booking.Cargos:output_type -> bookingpb.CargosReply
+	18, // 25: bookingpb.Booking.Locations:output_type -> bookingpb.LocationsReply
+	19, // [19:26] is the sub-list for method output_type
+	12, // [12:19] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_booking_proto_init() }
@@ -1317,7 +1476,7 @@ func file_booking_proto_init() {
 			}
 		}
 		file_booking_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoutesForCargoReply); i {
+			switch v := v.(*RouteConstraints); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1329,7 +1488,7 @@ func file_booking_proto_init() {
 			}
 		}
 		file_booking_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CargoToRouteRequest); i {
+			switch v := v.(*RoutesForCargoReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1341,7 +1500,7 @@ func file_booking_proto_init() {
 			}
 		}
 		file_booking_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CargoToRouteReply); i {
+			switch v := v.(*CargoToRouteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1353,7 +1512,7 @@ func file_booking_proto_init() {
 			}
 		}
 		file_booking_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeDestinationRequest); i {
+			switch v := v.(*CargoToRouteReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1365,7 +1524,7 @@ func file_booking_proto_init() {
 			}
 		}
 		file_booking_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeDestinationReply); i {
+			switch v := v.(*ChangeDestinationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1377,7 +1536,7 @@ func file_booking_proto_init() {
 			}
 		}
 		file_booking_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CargosRequest); i {
+			switch v := v.(*ChangeDestinationReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1389,7 +1548,7 @@ func file_booking_proto_init() {
 			}
 		}
 		file_booking_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CargosReply); i {
+			switch v := v.(*CargosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1401,7 +1560,7 @@ func file_booking_proto_init() {
 			}
 		}
 		file_booking_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LocationsRequest); i {
+			switch v := v.(*CargosReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1413,6 +1572,18 @@ func file_booking_proto_init() {
 			}
 		}
 		file_booking_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_booking_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LocationsReply); i {
 			case 0:
 				return &v.state
@@ -1431,7 +1602,7 @@ func file_booking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_booking_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1476,7 +1647,7 @@ func NewBookingClient(cc grpc.ClientConnInterface) BookingClient {
 
 func (c *bookingClient) BookNewCargo(ctx context.Context, in *NewCargoRequest, opts ...grpc.CallOption) (*NewCargoReply, error) {
 	out := new(NewCargoReply)
-	err := c.cc.Invoke(ctx, "/booking.Booking/BookNewCargo", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bookingpb.Booking/BookNewCargo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1485,7 +1656,7 @@ func (c *bookingClient) BookNewCargo(ctx context.Context, in *NewCargoRequest, o
 
 func (c *bookingClient) LoadCargo(ctx context.Context, in *LoadCargoRequest, opts ...grpc.CallOption) (*LoadCargoReply, error) {
 	out := new(LoadCargoReply)
-	err := c.cc.Invoke(ctx, "/booking.Booking/LoadCargo", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bookingpb.Booking/LoadCargo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1494,7 +1665,7 @@ func (c *bookingClient) LoadCargo(ctx context.Context, in *LoadCargoRequest, opt
 
 func (c *bookingClient) RequestPossibleRoutesForCargo(ctx context.Context, in *RoutesForCargoRequest, opts ...grpc.CallOption) (*RoutesForCargoReply, error) {
 	out := new(RoutesForCargoReply)
-	err := c.cc.Invoke(ctx, "/booking.Booking/RequestPossibleRoutesForCargo", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bookingpb.Booking/RequestPossibleRoutesForCargo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1503,7 +1674,7 @@ func (c *bookingClient) RequestPossibleRoutesForCargo(ctx context.Context, in *R
 
 func (c *bookingClient) AssignCargoToRoute(ctx context.Context, in *CargoToRouteRequest, opts ...grpc.CallOption) (*CargoToRouteReply, error) {
 	out := new(CargoToRouteReply)
-	err := c.cc.Invoke(ctx, "/booking.Booking/AssignCargoToRoute", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bookingpb.Booking/AssignCargoToRoute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1512,7 +1683,7 @@ func (c *bookingClient) AssignCargoToRoute(ctx context.Context, in *CargoToRoute
 
 func (c *bookingClient) ChangeDestination(ctx context.Context, in *ChangeDestinationRequest, opts ...grpc.CallOption) (*ChangeDestinationReply, error) {
 	out := new(ChangeDestinationReply)
-	err := c.cc.Invoke(ctx, "/booking.Booking/ChangeDestination", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bookingpb.Booking/ChangeDestination", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1521,7 +1692,7 @@ func (c *bookingClient) ChangeDestination(ctx context.Context, in *ChangeDestina
 
 func (c *bookingClient) Cargos(ctx context.Context, in *CargosRequest, opts ...grpc.CallOption) (*CargosReply, error) {
 	out := new(CargosReply)
-	err := c.cc.Invoke(ctx, "/booking.Booking/Cargos", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bookingpb.Booking/Cargos", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1530,7 +1701,7 @@ func (c *bookingClient) Cargos(ctx context.Context, in *CargosRequest, opts ...g
 
 func (c *bookingClient) Locations(ctx context.Context, in *LocationsRequest, opts ...grpc.CallOption) (*LocationsReply, error) {
 	out := new(LocationsReply)
-	err := c.cc.Invoke(ctx, "/booking.Booking/Locations", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/bookingpb.Booking/Locations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1588,7 +1759,7 @@ func _Booking_BookNewCargo_Handler(srv interface{}, ctx context.Context, dec fun
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/booking.Booking/BookNewCargo",
+		FullMethod: "/bookingpb.Booking/BookNewCargo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BookingServer).BookNewCargo(ctx, req.(*NewCargoRequest))
@@ -1606,7 +1777,7 @@ func _Booking_LoadCargo_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/booking.Booking/LoadCargo",
+		FullMethod: "/bookingpb.Booking/LoadCargo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BookingServer).LoadCargo(ctx, req.(*LoadCargoRequest))
@@ -1624,7 +1795,7 @@ func _Booking_RequestPossibleRoutesForCargo_Handler(srv interface{}, ctx context
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/booking.Booking/RequestPossibleRoutesForCargo",
+		FullMethod: "/bookingpb.Booking/RequestPossibleRoutesForCargo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BookingServer).RequestPossibleRoutesForCargo(ctx, req.(*RoutesForCargoRequest))
@@ -1642,7 +1813,7 @@ func _Booking_AssignCargoToRoute_Handler(srv interface{}, ctx context.Context, d
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/booking.Booking/AssignCargoToRoute",
+		FullMethod: "/bookingpb.Booking/AssignCargoToRoute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BookingServer).AssignCargoToRoute(ctx, req.(*CargoToRouteRequest))
@@ -1660,7 +1831,7 @@ func _Booking_ChangeDestination_Handler(srv interface{}, ctx context.Context, de
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/booking.Booking/ChangeDestination",
+		FullMethod: "/bookingpb.Booking/ChangeDestination",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BookingServer).ChangeDestination(ctx, req.(*ChangeDestinationRequest))
@@ -1678,7 +1849,7 @@ func _Booking_Cargos_Handler(srv interface{}, ctx context.Context, dec func(inte
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/booking.Booking/Cargos",
+		FullMethod: "/bookingpb.Booking/Cargos",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BookingServer).Cargos(ctx, req.(*CargosRequest))
@@ -1696,7 +1867,7 @@ func _Booking_Locations_Handler(srv interface{}, ctx context.Context, dec func(i
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/booking.Booking/Locations",
+		FullMethod: "/bookingpb.Booking/Locations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BookingServer).Locations(ctx, req.(*LocationsRequest))
@@ -1705,7 +1876,7 @@ func _Booking_Locations_Handler(srv interface{}, ctx context.Context, dec func(i
 }
 
 var _Booking_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "booking.Booking",
+	ServiceName: "bookingpb.Booking",
 	HandlerType: (*BookingServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
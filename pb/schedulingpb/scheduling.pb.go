@@ -0,0 +1,1587 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: scheduling.proto
+
+package schedulingpb
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type CarrierMovement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepartureLocation string               `protobuf:"bytes,1,opt,name=departure_location,json=departureLocation,proto3" json:"departure_location,omitempty"`
+	ArrivalLocation   string               `protobuf:"bytes,2,opt,name=arrival_location,json=arrivalLocation,proto3" json:"arrival_location,omitempty"`
+	DepartureTime     *timestamp.Timestamp `protobuf:"bytes,3,opt,name=departure_time,json=departureTime,proto3" json:"departure_time,omitempty"`
+	ArrivalTime       *timestamp.Timestamp `protobuf:"bytes,4,opt,name=arrival_time,json=arrivalTime,proto3" json:"arrival_time,omitempty"`
+}
+
+func (x *CarrierMovement) Reset() {
+	*x = CarrierMovement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CarrierMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarrierMovement) ProtoMessage() {}
+
+func (x *CarrierMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarrierMovement.ProtoReflect.Descriptor instead.
+func (*CarrierMovement) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CarrierMovement) GetDepartureLocation() string {
+	if x != nil {
+		return x.DepartureLocation
+	}
+	return ""
+}
+
+func (x *CarrierMovement) GetArrivalLocation() string {
+	if x != nil {
+		return x.ArrivalLocation
+	}
+	return ""
+}
+
+func (x *CarrierMovement) GetDepartureTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.DepartureTime
+	}
+	return nil
+}
+
+func (x *CarrierMovement) GetArrivalTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ArrivalTime
+	}
+	return nil
+}
+
+type Voyage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoyageNumber string             `protobuf:"bytes,1,opt,name=voyage_number,json=voyageNumber,proto3" json:"voyage_number,omitempty"`
+	Movements    []*CarrierMovement `protobuf:"bytes,2,rep,name=movements,proto3" json:"movements,omitempty"`
+}
+
+func (x *Voyage) Reset() {
+	*x = Voyage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Voyage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Voyage) ProtoMessage() {}
+
+func (x *Voyage) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Voyage.ProtoReflect.Descriptor instead.
+func (*Voyage) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Voyage) GetVoyageNumber() string {
+	if x != nil {
+		return x.VoyageNumber
+	}
+	return ""
+}
+
+func (x *Voyage) GetMovements() []*CarrierMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+type CreateVoyageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoyageNumber string             `protobuf:"bytes,1,opt,name=voyage_number,json=voyageNumber,proto3" json:"voyage_number,omitempty"`
+	Movements    []*CarrierMovement `protobuf:"bytes,2,rep,name=movements,proto3" json:"movements,omitempty"`
+}
+
+func (x *CreateVoyageRequest) Reset() {
+	*x = CreateVoyageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVoyageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVoyageRequest) ProtoMessage() {}
+
+func (x *CreateVoyageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVoyageRequest.ProtoReflect.Descriptor instead.
+func (*CreateVoyageRequest) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateVoyageRequest) GetVoyageNumber() string {
+	if x != nil {
+		return x.VoyageNumber
+	}
+	return ""
+}
+
+func (x *CreateVoyageRequest) GetMovements() []*CarrierMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+type CreateVoyageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err string `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (x *CreateVoyageReply) Reset() {
+	*x = CreateVoyageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVoyageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVoyageReply) ProtoMessage() {}
+
+func (x *CreateVoyageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVoyageReply.ProtoReflect.Descriptor instead.
+func (*CreateVoyageReply) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateVoyageReply) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+type AddCarrierMovementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoyageNumber string           `protobuf:"bytes,1,opt,name=voyage_number,json=voyageNumber,proto3" json:"voyage_number,omitempty"`
+	Movement     *CarrierMovement `protobuf:"bytes,2,opt,name=movement,proto3" json:"movement,omitempty"`
+}
+
+func (x *AddCarrierMovementRequest) Reset() {
+	*x = AddCarrierMovementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCarrierMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCarrierMovementRequest) ProtoMessage() {}
+
+func (x *AddCarrierMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCarrierMovementRequest.ProtoReflect.Descriptor instead.
+func (*AddCarrierMovementRequest) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddCarrierMovementRequest) GetVoyageNumber() string {
+	if x != nil {
+		return x.VoyageNumber
+	}
+	return ""
+}
+
+func (x *AddCarrierMovementRequest) GetMovement() *CarrierMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+type AddCarrierMovementReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err string `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (x *AddCarrierMovementReply) Reset() {
+	*x = AddCarrierMovementReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCarrierMovementReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCarrierMovementReply) ProtoMessage() {}
+
+func (x *AddCarrierMovementReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCarrierMovementReply.ProtoReflect.Descriptor instead.
+func (*AddCarrierMovementReply) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddCarrierMovementReply) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+type ModifyCarrierMovementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoyageNumber string           `protobuf:"bytes,1,opt,name=voyage_number,json=voyageNumber,proto3" json:"voyage_number,omitempty"`
+	Index        int32            `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Movement     *CarrierMovement `protobuf:"bytes,3,opt,name=movement,proto3" json:"movement,omitempty"`
+}
+
+func (x *ModifyCarrierMovementRequest) Reset() {
+	*x = ModifyCarrierMovementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyCarrierMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyCarrierMovementRequest) ProtoMessage() {}
+
+func (x *ModifyCarrierMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyCarrierMovementRequest.ProtoReflect.Descriptor instead.
+func (*ModifyCarrierMovementRequest) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ModifyCarrierMovementRequest) GetVoyageNumber() string {
+	if x != nil {
+		return x.VoyageNumber
+	}
+	return ""
+}
+
+func (x *ModifyCarrierMovementRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ModifyCarrierMovementRequest) GetMovement() *CarrierMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+type ModifyCarrierMovementReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err string `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (x *ModifyCarrierMovementReply) Reset() {
+	*x = ModifyCarrierMovementReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyCarrierMovementReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyCarrierMovementReply) ProtoMessage() {}
+
+func (x *ModifyCarrierMovementReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyCarrierMovementReply.ProtoReflect.Descriptor instead.
+func (*ModifyCarrierMovementReply) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ModifyCarrierMovementReply) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+type CancelCarrierMovementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoyageNumber string `protobuf:"bytes,1,opt,name=voyage_number,json=voyageNumber,proto3" json:"voyage_number,omitempty"`
+	Index        int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *CancelCarrierMovementRequest) Reset() {
+	*x = CancelCarrierMovementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelCarrierMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCarrierMovementRequest) ProtoMessage() {}
+
+func (x *CancelCarrierMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCarrierMovementRequest.ProtoReflect.Descriptor instead.
+func (*CancelCarrierMovementRequest) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CancelCarrierMovementRequest) GetVoyageNumber() string {
+	if x != nil {
+		return x.VoyageNumber
+	}
+	return ""
+}
+
+func (x *CancelCarrierMovementRequest) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type CancelCarrierMovementReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err string `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (x *CancelCarrierMovementReply) Reset() {
+	*x = CancelCarrierMovementReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelCarrierMovementReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelCarrierMovementReply) ProtoMessage() {}
+
+func (x *CancelCarrierMovementReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelCarrierMovementReply.ProtoReflect.Descriptor instead.
+func (*CancelCarrierMovementReply) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CancelCarrierMovementReply) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+type DelayVoyageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoyageNumber string `protobuf:"bytes,1,opt,name=voyage_number,json=voyageNumber,proto3" json:"voyage_number,omitempty"`
+	// movement is the index of the first carrier movement delayed.
+	Movement     int32 `protobuf:"varint,2,opt,name=movement,proto3" json:"movement,omitempty"`
+	DelaySeconds int64 `protobuf:"varint,3,opt,name=delay_seconds,json=delaySeconds,proto3" json:"delay_seconds,omitempty"`
+}
+
+func (x *DelayVoyageRequest) Reset() {
+	*x = DelayVoyageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelayVoyageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelayVoyageRequest) ProtoMessage() {}
+
+func (x *DelayVoyageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelayVoyageRequest.ProtoReflect.Descriptor instead.
+func (*DelayVoyageRequest) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DelayVoyageRequest) GetVoyageNumber() string {
+	if x != nil {
+		return x.VoyageNumber
+	}
+	return ""
+}
+
+func (x *DelayVoyageRequest) GetMovement() int32 {
+	if x != nil {
+		return x.Movement
+	}
+	return 0
+}
+
+func (x *DelayVoyageRequest) GetDelaySeconds() int64 {
+	if x != nil {
+		return x.DelaySeconds
+	}
+	return 0
+}
+
+type DelayVoyageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Err string `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (x *DelayVoyageReply) Reset() {
+	*x = DelayVoyageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelayVoyageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelayVoyageReply) ProtoMessage() {}
+
+func (x *DelayVoyageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelayVoyageReply.ProtoReflect.Descriptor instead.
+func (*DelayVoyageReply) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DelayVoyageReply) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+type LoadVoyageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoyageNumber string `protobuf:"bytes,1,opt,name=voyage_number,json=voyageNumber,proto3" json:"voyage_number,omitempty"`
+}
+
+func (x *LoadVoyageRequest) Reset() {
+	*x = LoadVoyageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadVoyageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadVoyageRequest) ProtoMessage() {}
+
+func (x *LoadVoyageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadVoyageRequest.ProtoReflect.Descriptor instead.
+func (*LoadVoyageRequest) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LoadVoyageRequest) GetVoyageNumber() string {
+	if x != nil {
+		return x.VoyageNumber
+	}
+	return ""
+}
+
+type LoadVoyageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voyage *Voyage `protobuf:"bytes,1,opt,name=voyage,proto3" json:"voyage,omitempty"`
+	Err    string  `protobuf:"bytes,2,opt,name=err,proto3" json:"err,omitempty"`
+}
+
+func (x *LoadVoyageReply) Reset() {
+	*x = LoadVoyageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadVoyageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadVoyageReply) ProtoMessage() {}
+
+func (x *LoadVoyageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadVoyageReply.ProtoReflect.Descriptor instead.
+func (*LoadVoyageReply) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LoadVoyageReply) GetVoyage() *Voyage {
+	if x != nil {
+		return x.Voyage
+	}
+	return nil
+}
+
+func (x *LoadVoyageReply) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+type VoyagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VoyagesRequest) Reset() {
+	*x = VoyagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoyagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoyagesRequest) ProtoMessage() {}
+
+func (x *VoyagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoyagesRequest.ProtoReflect.Descriptor instead.
+func (*VoyagesRequest) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{14}
+}
+
+type VoyagesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voyages []*Voyage `protobuf:"bytes,1,rep,name=voyages,proto3" json:"voyages,omitempty"`
+}
+
+func (x *VoyagesReply) Reset() {
+	*x = VoyagesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scheduling_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoyagesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoyagesReply) ProtoMessage() {}
+
+func (x *VoyagesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_scheduling_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoyagesReply.ProtoReflect.Descriptor instead.
+func (*VoyagesReply) Descriptor() ([]byte, []int) {
+	return file_scheduling_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VoyagesReply) GetVoyages() []*Voyage {
+	if x != nil {
+		return x.Voyages
+	}
+	return nil
+}
+
+var File_scheduling_proto protoreflect.FileDescriptor
+
+var file_scheduling_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xed, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x41, 0x0a, 0x0e, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x6a, 0x0a, 0x06, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76,
+	0x6f, 0x79, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x3b, 0x0a, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67,
+	0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x09, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x77, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x6f, 0x79,
+	0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x09, 0x6d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x72,
+	0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x6d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x25, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x65,
+	0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x7b, 0x0a,
+	0x19, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f,
+	0x79, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x39, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62,
+	0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x17, 0x41, 0x64,
+	0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x1c, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x79, 0x61,
+	0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x39, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
+	0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2e,
+	0x0a, 0x1a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x72, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x59,
+	0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x2e, 0x0a, 0x1a, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x7a, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x24, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x56, 0x6f,
+	0x79, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x38, 0x0a, 0x11, 0x4c,
+	0x6f, 0x61, 0x64, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x51, 0x0a, 0x0f, 0x4c, 0x6f, 0x61, 0x64, 0x56, 0x6f, 0x79,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x06, 0x76, 0x6f, 0x79, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x52, 0x06,
+	0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x6f, 0x79, 0x61,
+	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x0c, 0x56, 0x6f,
+	0x79, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x6f,
+	0x79, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x79, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x76, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x73, 0x32, 0x96, 0x05, 0x0a, 0x0a, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a, 0x0c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x12, 0x21, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x6f, 0x79, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x66, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
+	0x6e, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x41, 0x64,
+	0x64, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x15, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x2a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e,
+	0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x79, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x2a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f,
+	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65, 0x72, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0b, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x56, 0x6f, 0x79,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x56,
+	0x6f, 0x79, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0a,
+	0x4c, 0x6f, 0x61, 0x64, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x56, 0x6f,
+	0x79, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x56,
+	0x6f, 0x79, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x07,
+	0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69,
+	0x6e, 0x67, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x79, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_scheduling_proto_rawDescOnce sync.Once
+	file_scheduling_proto_rawDescData = file_scheduling_proto_rawDesc
+)
+
+func file_scheduling_proto_rawDescGZIP() []byte {
+	file_scheduling_proto_rawDescOnce.Do(func() {
+		file_scheduling_proto_rawDescData = protoimpl.X.CompressGZIP(file_scheduling_proto_rawDescData)
+	})
+	return file_scheduling_proto_rawDescData
+}
+
+var file_scheduling_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_scheduling_proto_goTypes = []interface{}{
+	(*CarrierMovement)(nil),              // 0: schedulingpb.CarrierMovement
+	(*Voyage)(nil),                       // 1: schedulingpb.Voyage
+	(*CreateVoyageRequest)(nil),          // 2: schedulingpb.CreateVoyageRequest
+	(*CreateVoyageReply)(nil),            // 3: schedulingpb.CreateVoyageReply
+	(*AddCarrierMovementRequest)(nil),    // 4: schedulingpb.AddCarrierMovementRequest
+	(*AddCarrierMovementReply)(nil),      // 5: schedulingpb.AddCarrierMovementReply
+	(*ModifyCarrierMovementRequest)(nil), // 6: schedulingpb.ModifyCarrierMovementRequest
+	(*ModifyCarrierMovementReply)(nil),   // 7: schedulingpb.ModifyCarrierMovementReply
+	(*CancelCarrierMovementRequest)(nil), // 8: schedulingpb.CancelCarrierMovementRequest
+	(*CancelCarrierMovementReply)(nil),   // 9: schedulingpb.CancelCarrierMovementReply
+	(*DelayVoyageRequest)(nil),           // 10: schedulingpb.DelayVoyageRequest
+	(*DelayVoyageReply)(nil),             // 11: schedulingpb.DelayVoyageReply
+	(*LoadVoyageRequest)(nil),            // 12: schedulingpb.LoadVoyageRequest
+	(*LoadVoyageReply)(nil),              // 13: schedulingpb.LoadVoyageReply
+	(*VoyagesRequest)(nil),               // 14: schedulingpb.VoyagesRequest
+	(*VoyagesReply)(nil),                 // 15: schedulingpb.VoyagesReply
+	(*timestamp.Timestamp)(nil),          // 16: google.protobuf.Timestamp
+}
+var file_scheduling_proto_depIdxs = []int32{
+	16, // 0: schedulingpb.CarrierMovement.departure_time:type_name -> google.protobuf.Timestamp
+	16, // 1: schedulingpb.CarrierMovement.arrival_time:type_name -> google.protobuf.Timestamp
+	0,  // 2: schedulingpb.Voyage.movements:type_name -> schedulingpb.CarrierMovement
+	0,  // 3: schedulingpb.CreateVoyageRequest.movements:type_name -> schedulingpb.CarrierMovement
+	0,  // 4: schedulingpb.AddCarrierMovementRequest.movement:type_name -> schedulingpb.CarrierMovement
+	0,  // 5: schedulingpb.ModifyCarrierMovementRequest.movement:type_name -> schedulingpb.CarrierMovement
+	1,  // 6: schedulingpb.LoadVoyageReply.voyage:type_name -> schedulingpb.Voyage
+	1,  // 7: schedulingpb.VoyagesReply.voyages:type_name -> schedulingpb.Voyage
+	2,  // 8: schedulingpb.Scheduling.CreateVoyage:input_type -> schedulingpb.CreateVoyageRequest
+	4,  // 9: schedulingpb.Scheduling.AddCarrierMovement:input_type -> schedulingpb.AddCarrierMovementRequest
+	6,  // 10: schedulingpb.Scheduling.ModifyCarrierMovement:input_type -> schedulingpb.ModifyCarrierMovementRequest
+	8,  // 11: schedulingpb.Scheduling.CancelCarrierMovement:input_type -> schedulingpb.CancelCarrierMovementRequest
+	10, // 12: schedulingpb.Scheduling.DelayVoyage:input_type -> schedulingpb.DelayVoyageRequest
+	12, // 13: schedulingpb.Scheduling.LoadVoyage:input_type -> schedulingpb.LoadVoyageRequest
+	14, // 14: schedulingpb.Scheduling.Voyages:input_type -> schedulingpb.VoyagesRequest
+	3,  // 15: schedulingpb.Scheduling.CreateVoyage:output_type -> schedulingpb.CreateVoyageReply
+	5,  // 16: schedulingpb.Scheduling.AddCarrierMovement:output_type -> schedulingpb.AddCarrierMovementReply
+	7,  // 17: schedulingpb.Scheduling.ModifyCarrierMovement:output_type -> schedulingpb.ModifyCarrierMovementReply
+	9,  // 18: schedulingpb.Scheduling.CancelCarrierMovement:output_type -> schedulingpb.CancelCarrierMovementReply
+	11, // 19: schedulingpb.Scheduling.DelayVoyage:output_type -> schedulingpb.DelayVoyageReply
+	13, // 20: schedulingpb.Scheduling.LoadVoyage:output_type -> schedulingpb.LoadVoyageReply
+	15, // 21: schedulingpb.Scheduling.Voyages:output_type -> schedulingpb.VoyagesReply
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_scheduling_proto_init() }
+func file_scheduling_proto_init() {
+	if File_scheduling_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_scheduling_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CarrierMovement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Voyage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVoyageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVoyageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCarrierMovementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCarrierMovementReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyCarrierMovementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyCarrierMovementReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelCarrierMovementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelCarrierMovementReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelayVoyageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelayVoyageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadVoyageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadVoyageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoyagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scheduling_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoyagesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_scheduling_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_scheduling_proto_goTypes,
+		DependencyIndexes: file_scheduling_proto_depIdxs,
+		MessageInfos:      file_scheduling_proto_msgTypes,
+	}.Build()
+	File_scheduling_proto = out.File
+	file_scheduling_proto_rawDesc = nil
+	file_scheduling_proto_goTypes = nil
+	file_scheduling_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// SchedulingClient is the client API for Scheduling service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SchedulingClient interface {
+	CreateVoyage(ctx context.Context, in *CreateVoyageRequest, opts ...grpc.CallOption) (*CreateVoyageReply, error)
+	AddCarrierMovement(ctx context.Context, in *AddCarrierMovementRequest, opts ...grpc.CallOption) (*AddCarrierMovementReply, error)
+	ModifyCarrierMovement(ctx context.Context, in *ModifyCarrierMovementRequest, opts ...grpc.CallOption) (*ModifyCarrierMovementReply, error)
+	CancelCarrierMovement(ctx context.Context, in *CancelCarrierMovementRequest, opts ...grpc.CallOption) (*CancelCarrierMovementReply, error)
+	DelayVoyage(ctx context.Context, in *DelayVoyageRequest, opts ...grpc.CallOption) (*DelayVoyageReply, error)
+	LoadVoyage(ctx context.Context, in *LoadVoyageRequest, opts ...grpc.CallOption) (*LoadVoyageReply, error)
+	Voyages(ctx context.Context, in *VoyagesRequest, opts ...grpc.CallOption) (*VoyagesReply, error)
+}
+
+type schedulingClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSchedulingClient(cc grpc.ClientConnInterface) SchedulingClient {
+	return &schedulingClient{cc}
+}
+
+func (c *schedulingClient) CreateVoyage(ctx context.Context, in *CreateVoyageRequest, opts ...grpc.CallOption) (*CreateVoyageReply, error) {
+	out := new(CreateVoyageReply)
+	err := c.cc.Invoke(ctx, "/schedulingpb.Scheduling/CreateVoyage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulingClient) AddCarrierMovement(ctx context.Context, in *AddCarrierMovementRequest, opts ...grpc.CallOption) (*AddCarrierMovementReply, error) {
+	out := new(AddCarrierMovementReply)
+	err := c.cc.Invoke(ctx, "/schedulingpb.Scheduling/AddCarrierMovement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulingClient) ModifyCarrierMovement(ctx context.Context, in *ModifyCarrierMovementRequest, opts ...grpc.CallOption) (*ModifyCarrierMovementReply, error) {
+	out := new(ModifyCarrierMovementReply)
+	err := c.cc.Invoke(ctx, "/schedulingpb.Scheduling/ModifyCarrierMovement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulingClient) CancelCarrierMovement(ctx context.Context, in *CancelCarrierMovementRequest, opts ...grpc.CallOption) (*CancelCarrierMovementReply, error) {
+	out := new(CancelCarrierMovementReply)
+	err := c.cc.Invoke(ctx, "/schedulingpb.Scheduling/CancelCarrierMovement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulingClient) DelayVoyage(ctx context.Context, in *DelayVoyageRequest, opts ...grpc.CallOption) (*DelayVoyageReply, error) {
+	out := new(DelayVoyageReply)
+	err := c.cc.Invoke(ctx, "/schedulingpb.Scheduling/DelayVoyage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulingClient) LoadVoyage(ctx context.Context, in *LoadVoyageRequest, opts ...grpc.CallOption) (*LoadVoyageReply, error) {
+	out := new(LoadVoyageReply)
+	err := c.cc.Invoke(ctx, "/schedulingpb.Scheduling/LoadVoyage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *schedulingClient) Voyages(ctx context.Context, in *VoyagesRequest, opts ...grpc.CallOption) (*VoyagesReply, error) {
+	out := new(VoyagesReply)
+	err := c.cc.Invoke(ctx, "/schedulingpb.Scheduling/Voyages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SchedulingServer is the server API for Scheduling service.
+type SchedulingServer interface {
+	CreateVoyage(context.Context, *CreateVoyageRequest) (*CreateVoyageReply, error)
+	AddCarrierMovement(context.Context, *AddCarrierMovementRequest) (*AddCarrierMovementReply, error)
+	ModifyCarrierMovement(context.Context, *ModifyCarrierMovementRequest) (*ModifyCarrierMovementReply, error)
+	CancelCarrierMovement(context.Context, *CancelCarrierMovementRequest) (*CancelCarrierMovementReply, error)
+	DelayVoyage(context.Context, *DelayVoyageRequest) (*DelayVoyageReply, error)
+	LoadVoyage(context.Context, *LoadVoyageRequest) (*LoadVoyageReply, error)
+	Voyages(context.Context, *VoyagesRequest) (*VoyagesReply, error)
+}
+
+// UnimplementedSchedulingServer can be embedded to have forward compatible implementations.
+type UnimplementedSchedulingServer struct {
+}
+
+func (*UnimplementedSchedulingServer) CreateVoyage(context.Context, *CreateVoyageRequest) (*CreateVoyageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVoyage not implemented")
+}
+func (*UnimplementedSchedulingServer) AddCarrierMovement(context.Context, *AddCarrierMovementRequest) (*AddCarrierMovementReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCarrierMovement not implemented")
+}
+func (*UnimplementedSchedulingServer) ModifyCarrierMovement(context.Context, *ModifyCarrierMovementRequest) (*ModifyCarrierMovementReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyCarrierMovement not implemented")
+}
+func (*UnimplementedSchedulingServer) CancelCarrierMovement(context.Context, *CancelCarrierMovementRequest) (*CancelCarrierMovementReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelCarrierMovement not implemented")
+}
+func (*UnimplementedSchedulingServer) DelayVoyage(context.Context, *DelayVoyageRequest) (*DelayVoyageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelayVoyage not implemented")
+}
+func (*UnimplementedSchedulingServer) LoadVoyage(context.Context, *LoadVoyageRequest) (*LoadVoyageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadVoyage not implemented")
+}
+func (*UnimplementedSchedulingServer) Voyages(context.Context, *VoyagesRequest) (*VoyagesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Voyages not implemented")
+}
+
+func RegisterSchedulingServer(s *grpc.Server, srv SchedulingServer) {
+	s.RegisterService(&_Scheduling_serviceDesc, srv)
+}
+
+func _Scheduling_CreateVoyage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVoyageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServer).CreateVoyage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulingpb.Scheduling/CreateVoyage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServer).CreateVoyage(ctx, req.(*CreateVoyageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduling_AddCarrierMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCarrierMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServer).AddCarrierMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulingpb.Scheduling/AddCarrierMovement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServer).AddCarrierMovement(ctx, req.(*AddCarrierMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduling_ModifyCarrierMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyCarrierMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServer).ModifyCarrierMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulingpb.Scheduling/ModifyCarrierMovement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServer).ModifyCarrierMovement(ctx, req.(*ModifyCarrierMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduling_CancelCarrierMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelCarrierMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServer).CancelCarrierMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulingpb.Scheduling/CancelCarrierMovement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServer).CancelCarrierMovement(ctx, req.(*CancelCarrierMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduling_DelayVoyage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelayVoyageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServer).DelayVoyage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulingpb.Scheduling/DelayVoyage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServer).DelayVoyage(ctx, req.(*DelayVoyageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduling_LoadVoyage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadVoyageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServer).LoadVoyage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulingpb.Scheduling/LoadVoyage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServer).LoadVoyage(ctx, req.(*LoadVoyageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scheduling_Voyages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoyagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SchedulingServer).Voyages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/schedulingpb.Scheduling/Voyages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SchedulingServer).Voyages(ctx, req.(*VoyagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Scheduling_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "schedulingpb.Scheduling",
+	HandlerType: (*SchedulingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVoyage",
+			Handler:    _Scheduling_CreateVoyage_Handler,
+		},
+		{
+			MethodName: "AddCarrierMovement",
+			Handler:    _Scheduling_AddCarrierMovement_Handler,
+		},
+		{
+			MethodName: "ModifyCarrierMovement",
+			Handler:    _Scheduling_ModifyCarrierMovement_Handler,
+		},
+		{
+			MethodName: "CancelCarrierMovement",
+			Handler:    _Scheduling_CancelCarrierMovement_Handler,
+		},
+		{
+			MethodName: "DelayVoyage",
+			Handler:    _Scheduling_DelayVoyage_Handler,
+		},
+		{
+			MethodName: "LoadVoyage",
+			Handler:    _Scheduling_LoadVoyage_Handler,
+		},
+		{
+			MethodName: "Voyages",
+			Handler:    _Scheduling_Voyages_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "scheduling.proto",
+}
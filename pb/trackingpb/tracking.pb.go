@@ -35,8 +35,13 @@ type Event struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	Expected    bool   `protobuf:"varint,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	Description      string               `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Expected         bool                 `protobuf:"varint,2,opt,name=expected,proto3" json:"expected,omitempty"`
+	CompletionTime   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
+	RegistrationTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=registration_time,json=registrationTime,proto3" json:"registration_time,omitempty"`
+	Location         string               `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	// time_zone is the IANA time zone the times are meant to be shown in.
+	TimeZone string `protobuf:"bytes,6,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 }
 
 func (x *Event) Reset() {
@@ -85,6 +90,34 @@ func (x *Event) GetExpected() bool {
 	return false
 }
 
+func (x *Event) GetCompletionTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.CompletionTime
+	}
+	return nil
+}
+
+func (x *Event) GetRegistrationTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.RegistrationTime
+	}
+	return nil
+}
+
+func (x *Event) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Event) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 type Cargo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -98,6 +131,9 @@ type Cargo struct {
 	NextExpectedActivity string               `protobuf:"bytes,6,opt,name=next_expected_activity,json=nextExpectedActivity,proto3" json:"next_expected_activity,omitempty"`
 	Deadline             *timestamp.Timestamp `protobuf:"bytes,7,opt,name=deadline,proto3" json:"deadline,omitempty"`
 	Events               []*Event             `protobuf:"bytes,8,rep,name=events,proto3" json:"events,omitempty"`
+	// time_zone is the IANA time zone the eta and deadline are meant to be
+	// shown in.
+	TimeZone string `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
 }
 
 func (x *Cargo) Reset() {
@@ -188,12 +224,21 @@ func (x *Cargo) GetEvents() []*Event {
 	return nil
 }
 
+func (x *Cargo) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
 type TrackRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	TrackingId string `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	// tz optionally names an IANA time zone to show all times in.
+	Tz string `protobuf:"bytes,2,opt,name=tz,proto3" json:"tz,omitempty"`
 }
 
 func (x *TrackRequest) Reset() {
@@ -235,6 +280,13 @@ func (x *TrackRequest) GetTrackingId() string {
 	return ""
 }
 
+func (x *TrackRequest) GetTz() string {
+	if x != nil {
+		return x.Tz
+	}
+	return ""
+}
+
 type TrackReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -290,52 +342,275 @@ func (x *TrackReply) GetErr() string {
 	return ""
 }
 
+type WatchCargoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackingId string `protobuf:"bytes,1,opt,name=tracking_id,json=trackingId,proto3" json:"tracking_id,omitempty"`
+	Tz         string `protobuf:"bytes,2,opt,name=tz,proto3" json:"tz,omitempty"`
+	// after optionally resumes the stream after the seq of the last reply
+	// received. The current snapshot is sent first if it is zero, or if the
+	// events since are no longer known.
+	After uint64 `protobuf:"varint,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *WatchCargoRequest) Reset() {
+	*x = WatchCargoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tracking_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCargoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCargoRequest) ProtoMessage() {}
+
+func (x *WatchCargoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracking_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCargoRequest.ProtoReflect.Descriptor instead.
+func (*WatchCargoRequest) Descriptor() ([]byte, []int) {
+	return file_tracking_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WatchCargoRequest) GetTrackingId() string {
+	if x != nil {
+		return x.TrackingId
+	}
+	return ""
+}
+
+func (x *WatchCargoRequest) GetTz() string {
+	if x != nil {
+		return x.Tz
+	}
+	return ""
+}
+
+func (x *WatchCargoRequest) GetAfter() uint64 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+type WatchCargosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackingIds []string `protobuf:"bytes,1,rep,name=tracking_ids,json=trackingIds,proto3" json:"tracking_ids,omitempty"`
+	Tz          string   `protobuf:"bytes,2,opt,name=tz,proto3" json:"tz,omitempty"`
+	After       uint64   `protobuf:"varint,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *WatchCargosRequest) Reset() {
+	*x = WatchCargosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tracking_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCargosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCargosRequest) ProtoMessage() {}
+
+func (x *WatchCargosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_tracking_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCargosRequest.ProtoReflect.Descriptor instead.
+func (*WatchCargosRequest) Descriptor() ([]byte, []int) {
+	return file_tracking_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WatchCargosRequest) GetTrackingIds() []string {
+	if x != nil {
+		return x.TrackingIds
+	}
+	return nil
+}
+
+func (x *WatchCargosRequest) GetTz() string {
+	if x != nil {
+		return x.Tz
+	}
+	return ""
+}
+
+func (x *WatchCargosRequest) GetAfter() uint64 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+type WatchReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq   uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Cargo *Cargo `protobuf:"bytes,2,opt,name=cargo,proto3" json:"cargo,omitempty"`
+}
+
+func (x *WatchReply) Reset() {
+	*x = WatchReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tracking_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReply) ProtoMessage() {}
+
+func (x *WatchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tracking_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReply.ProtoReflect.Descriptor instead.
+func (*WatchReply) Descriptor() ([]byte, []int) {
+	return file_tracking_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchReply) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *WatchReply) GetCargo() *Cargo {
+	if x != nil {
+		return x.Cargo
+	}
+	return nil
+}
+
 var File_tracking_proto protoreflect.FileDescriptor
 
 var file_tracking_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x05, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x65, 0x64, 0x22, 0xb7, 0x02, 0x0a, 0x05, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x74, 0x61, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x03, 0x65, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x78,
-	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x08, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x2f, 0x0a, 0x0c,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x22, 0x45, 0x0a,
-	0x0a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x63,
-	0x61, 0x72, 0x67, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x05, 0x63, 0x61, 0x72,
-	0x67, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x65, 0x72, 0x72, 0x32, 0x43, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
-	0x12, 0x37, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x51, 0x61, 0x6c, 0x69, 0x66, 0x61, 0x68, 0x2f,
-	0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x02,
+	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x11, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0xd6, 0x02, 0x0a,
+	0x05, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x54, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x74, 0x61, 0x12,
+	0x34, 0x0a, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x29, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x3f, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x7a, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x7a, 0x22, 0x47, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62,
+	0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x05, 0x63, 0x61, 0x72, 0x67, 0x6f, 0x12, 0x10, 0x0a,
+	0x03, 0x65, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x72, 0x22,
+	0x5a, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x7a, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x5d, 0x0a, 0x12, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x7a, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x0a, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x52, 0x05, 0x63, 0x61,
+	0x72, 0x67, 0x6f, 0x32, 0xdb, 0x01, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x12, 0x3b, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a,
+	0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x12, 0x1d, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61,
+	0x72, 0x67, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x69, 0x6e, 0x67, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x61, 0x72, 0x67, 0x6f, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x72, 0x67, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x70, 0x62, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30,
+	0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -350,26 +625,36 @@ func file_tracking_proto_rawDescGZIP() []byte {
 	return file_tracking_proto_rawDescData
 }
 
-var file_tracking_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_tracking_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_tracking_proto_goTypes = []interface{}{
-	(*Event)(nil),               // 0: tracking.Event
-	(*Cargo)(nil),               // 1: tracking.Cargo
-	(*TrackRequest)(nil),        // 2: tracking.TrackRequest
-	(*TrackReply)(nil),          // 3: tracking.TrackReply
-	(*timestamp.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(*Event)(nil),               // 0: trackingpb.Event
+	(*Cargo)(nil),               // 1: trackingpb.Cargo
+	(*TrackRequest)(nil),        // 2: trackingpb.TrackRequest
+	(*TrackReply)(nil),          // 3: trackingpb.TrackReply
+	(*WatchCargoRequest)(nil),   // 4: trackingpb.WatchCargoRequest
+	(*WatchCargosRequest)(nil),  // 5: trackingpb.WatchCargosRequest
+	(*WatchReply)(nil),          // 6: trackingpb.WatchReply
+	(*timestamp.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_tracking_proto_depIdxs = []int32{
-	4, // 0: tracking.Cargo.eta:type_name -> google.protobuf.Timestamp
-	4, // 1: tracking.Cargo.deadline:type_name -> google.protobuf.Timestamp
-	0, // 2: tracking.Cargo.events:type_name -> tracking.Event
-	1, // 3: tracking.TrackReply.cargo:type_name -> tracking.Cargo
-	2, // 4: tracking.Tracking.Track:input_type -> tracking.TrackRequest
-	3, // 5: tracking.Tracking.Track:output_type -> tracking.TrackReply
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	7,  // 0: trackingpb.Event.completion_time:type_name -> google.protobuf.Timestamp
+	7,  // 1: trackingpb.Event.registration_time:type_name -> google.protobuf.Timestamp
+	7,  // 2: trackingpb.Cargo.eta:type_name -> google.protobuf.Timestamp
+	7,  // 3: trackingpb.Cargo.deadline:type_name -> google.protobuf.Timestamp
+	0,  // 4: trackingpb.Cargo.events:type_name -> trackingpb.Event
+	1,  // 5: trackingpb.TrackReply.cargo:type_name -> trackingpb.Cargo
+	1,  // 6: trackingpb.WatchReply.cargo:type_name -> trackingpb.Cargo
+	2,  // 7: trackingpb.Tracking.Track:input_type -> trackingpb.TrackRequest
+	4,  // 8: trackingpb.Tracking.WatchCargo:input_type -> trackingpb.WatchCargoRequest
+	5,  // 9: trackingpb.Tracking.WatchCargos:input_type -> trackingpb.WatchCargosRequest
+	3,  // 10: trackingpb.Tracking.Track:output_type -> trackingpb.TrackReply
+	6,  // 11: trackingpb.Tracking.WatchCargo:output_type -> trackingpb.WatchReply
+	6,  // 12: trackingpb.Tracking.WatchCargos:output_type -> trackingpb.WatchReply
+	10, // [10:13] is the sub-list for method output_type
+	7,  // [7:10] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_tracking_proto_init() }
@@ -426,6 +711,42 @@ func file_tracking_proto_init() {
 				return nil
 			}
 		}
+		file_tracking_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCargoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tracking_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCargosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tracking_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -433,7 +754,7 @@ func file_tracking_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tracking_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -460,6 +781,11 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type TrackingClient interface {
 	Track(ctx context.Context, in *TrackRequest, opts ...grpc.CallOption) (*TrackReply, error)
+	// WatchCargo streams a snapshot of a cargo whenever it is handled.
+	WatchCargo(ctx context.Context, in *WatchCargoRequest, opts ...grpc.CallOption) (Tracking_WatchCargoClient, error)
+	// WatchCargos streams a snapshot of each of several cargos whenever it
+	// is handled.
+	WatchCargos(ctx context.Context, in *WatchCargosRequest, opts ...grpc.CallOption) (Tracking_WatchCargosClient, error)
 }
 
 type trackingClient struct {
@@ -472,16 +798,85 @@ func NewTrackingClient(cc grpc.ClientConnInterface) TrackingClient {
 
 func (c *trackingClient) Track(ctx context.Context, in *TrackRequest, opts ...grpc.CallOption) (*TrackReply, error) {
 	out := new(TrackReply)
-	err := c.cc.Invoke(ctx, "/tracking.Tracking/Track", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/trackingpb.Tracking/Track", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
+func (c *trackingClient) WatchCargo(ctx context.Context, in *WatchCargoRequest, opts ...grpc.CallOption) (Tracking_WatchCargoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tracking_serviceDesc.Streams[0], "/trackingpb.Tracking/WatchCargo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trackingWatchCargoClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tracking_WatchCargoClient interface {
+	Recv() (*WatchReply, error)
+	grpc.ClientStream
+}
+
+type trackingWatchCargoClient struct {
+	grpc.ClientStream
+}
+
+func (x *trackingWatchCargoClient) Recv() (*WatchReply, error) {
+	m := new(WatchReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *trackingClient) WatchCargos(ctx context.Context, in *WatchCargosRequest, opts ...grpc.CallOption) (Tracking_WatchCargosClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tracking_serviceDesc.Streams[1], "/trackingpb.Tracking/WatchCargos", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trackingWatchCargosClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tracking_WatchCargosClient interface {
+	Recv() (*WatchReply, error)
+	grpc.ClientStream
+}
+
+type trackingWatchCargosClient struct {
+	grpc.ClientStream
+}
+
+func (x *trackingWatchCargosClient) Recv() (*WatchReply, error) {
+	m := new(WatchReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // TrackingServer is the server API for Tracking service.
 type TrackingServer interface {
 	Track(context.Context, *TrackRequest) (*TrackReply, error)
+	// WatchCargo streams a snapshot of a cargo whenever it is handled.
+	WatchCargo(*WatchCargoRequest, Tracking_WatchCargoServer) error
+	// WatchCargos streams a snapshot of each of several cargos whenever it
+	// is handled.
+	WatchCargos(*WatchCargosRequest, Tracking_WatchCargosServer) error
 }
 
 // UnimplementedTrackingServer can be embedded to have forward compatible implementations.
@@ -491,6 +886,12 @@ type UnimplementedTrackingServer struct {
 func (*UnimplementedTrackingServer) Track(context.Context, *TrackRequest) (*TrackReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Track not implemented")
 }
+func (*UnimplementedTrackingServer) WatchCargo(*WatchCargoRequest, Tracking_WatchCargoServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCargo not implemented")
+}
+func (*UnimplementedTrackingServer) WatchCargos(*WatchCargosRequest, Tracking_WatchCargosServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCargos not implemented")
+}
 
 func RegisterTrackingServer(s *grpc.Server, srv TrackingServer) {
 	s.RegisterService(&_Tracking_serviceDesc, srv)
@@ -506,7 +907,7 @@ func _Tracking_Track_Handler(srv interface{}, ctx context.Context, dec func(inte
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/tracking.Tracking/Track",
+		FullMethod: "/trackingpb.Tracking/Track",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(TrackingServer).Track(ctx, req.(*TrackRequest))
@@ -514,8 +915,50 @@ func _Tracking_Track_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Tracking_WatchCargo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCargoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrackingServer).WatchCargo(m, &trackingWatchCargoServer{stream})
+}
+
+type Tracking_WatchCargoServer interface {
+	Send(*WatchReply) error
+	grpc.ServerStream
+}
+
+type trackingWatchCargoServer struct {
+	grpc.ServerStream
+}
+
+func (x *trackingWatchCargoServer) Send(m *WatchReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tracking_WatchCargos_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCargosRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrackingServer).WatchCargos(m, &trackingWatchCargosServer{stream})
+}
+
+type Tracking_WatchCargosServer interface {
+	Send(*WatchReply) error
+	grpc.ServerStream
+}
+
+type trackingWatchCargosServer struct {
+	grpc.ServerStream
+}
+
+func (x *trackingWatchCargosServer) Send(m *WatchReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Tracking_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tracking.Tracking",
+	ServiceName: "trackingpb.Tracking",
 	HandlerType: (*TrackingServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -523,6 +966,17 @@ var _Tracking_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Tracking_Track_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCargo",
+			Handler:       _Tracking_WatchCargo_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchCargos",
+			Handler:       _Tracking_WatchCargos_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "tracking.proto",
 }
@@ -0,0 +1,165 @@
+// Package pubsub provides an in-process publish/subscribe hub for registered
+// handling events, so that live views can be updated as cargos are handled.
+package pubsub
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/Qalifah/shipping/cargo"
+)
+
+// ErrUnknownSequence is returned when a subscription is to resume after a
+// sequence number whose successors are no longer retained, or that was never
+// published, e.g. because the process restarted since.
+var ErrUnknownSequence = errors.New("unknown sequence number")
+
+// ErrSlowSubscriber is the reason a subscription was closed when it fell too
+// far behind the published messages.
+var ErrSlowSubscriber = errors.New("subscriber too slow")
+
+// Message is a handling event, numbered in the order it was published.
+type Message struct {
+	Seq   uint64
+	Event cargo.HandlingEvent
+}
+
+// buffer is the number of messages a subscriber may fall behind by before
+// its subscription is closed.
+const buffer = 64
+
+// Hub publishes handling events to the subscribers of the cargos they
+// concern. The most recent messages are retained, so that subscribers that
+// reconnect can resume where they left off.
+type Hub struct {
+	mtx         sync.Mutex
+	seq         uint64
+	history     []Message
+	retain      int
+	subscribers map[*Subscription]struct{}
+}
+
+// NewHub returns a hub that retains the given number of messages. A negative
+// number retains none, like zero.
+func NewHub(retain int) *Hub {
+	if retain < 0 {
+		retain = 0
+	}
+	return &Hub{
+		retain:      retain,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish numbers a handling event and sends it to the subscribers of its
+// cargo. Subscribers that cannot keep up are closed rather than waited for.
+func (h *Hub) Publish(e cargo.HandlingEvent) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	h.seq++
+	m := Message{Seq: h.seq, Event: e}
+
+	h.history = append(h.history, m)
+	if len(h.history) > h.retain {
+		h.history = h.history[len(h.history)-h.retain:]
+	}
+
+	for s := range h.subscribers {
+		if !s.wants(e.TrackingID) {
+			continue
+		}
+		select {
+		case s.c <- m:
+		default:
+			s.close(ErrSlowSubscriber)
+			delete(h.subscribers, s)
+		}
+	}
+}
+
+// Subscribe subscribes to the handling events of the cargos. If after is not
+// zero, the retained messages published after that sequence number are sent
+// first. The subscription starts at the current sequence number otherwise.
+func (h *Hub) Subscribe(ids []cargo.TrackingID, after uint64) (*Subscription, error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	var replay []Message
+	if after != 0 {
+		oldest := h.seq + 1
+		if len(h.history) > 0 {
+			oldest = h.history[0].Seq
+		}
+		if after > h.seq || after+1 < oldest {
+			return nil, ErrUnknownSequence
+		}
+		for _, m := range h.history {
+			if m.Seq > after {
+				replay = append(replay, m)
+			}
+		}
+	}
+
+	s := &Subscription{
+		hub:   h,
+		ids:   make(map[cargo.TrackingID]bool),
+		c:     make(chan Message, buffer+len(replay)),
+		Start: h.seq,
+	}
+	for _, id := range ids {
+		s.ids[id] = true
+	}
+	for _, m := range replay {
+		if s.wants(m.Event.TrackingID) {
+			s.c <- m
+		}
+	}
+	h.subscribers[s] = struct{}{}
+
+	return s, nil
+}
+
+// Subscription receives the handling events of a set of cargos.
+type Subscription struct {
+	hub *Hub
+	ids map[cargo.TrackingID]bool
+	c   chan Message
+	err error
+
+	// Start is the sequence number of the last message published before
+	// the subscription started.
+	Start uint64
+}
+
+// C returns the channel messages are received on. It is closed when the
+// subscription is.
+func (s *Subscription) C() <-chan Message {
+	return s.c
+}
+
+// Err returns why the subscription was closed by the hub, if it was.
+func (s *Subscription) Err() error {
+	s.hub.mtx.Lock()
+	defer s.hub.mtx.Unlock()
+	return s.err
+}
+
+// Close ends the subscription.
+func (s *Subscription) Close() {
+	s.hub.mtx.Lock()
+	defer s.hub.mtx.Unlock()
+	if _, ok := s.hub.subscribers[s]; ok {
+		delete(s.hub.subscribers, s)
+		s.close(nil)
+	}
+}
+
+func (s *Subscription) wants(id cargo.TrackingID) bool {
+	return s.ids[id]
+}
+
+func (s *Subscription) close(err error) {
+	s.err = err
+	close(s.c)
+}
@@ -0,0 +1,183 @@
+package routing
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+// Constraints narrow down the itineraries a routing service may return. The
+// zero value allows any itinerary.
+type Constraints struct {
+	// ExcludedLocations may not be loaded or unloaded at.
+	ExcludedLocations []location.UNLcode
+
+	// AllowedVoyages, unless empty, are the only voyages that may be used.
+	AllowedVoyages []voyage.Number
+
+	// ForbiddenVoyages may not be used.
+	ForbiddenVoyages []voyage.Number
+
+	// MaxLegs, unless zero, is the maximum number of legs.
+	MaxLegs int
+
+	// MinConnectionTime is the minimum time between unloading a cargo and
+	// loading it onto the next voyage.
+	MinConnectionTime time.Duration
+}
+
+// IsZero reports whether c allows any itinerary.
+func (c Constraints) IsZero() bool {
+	return len(c.ExcludedLocations) == 0 && len(c.AllowedVoyages) == 0 && len(c.ForbiddenVoyages) == 0 &&
+		c.MaxLegs == 0 && c.MinConnectionTime == 0
+}
+
+func (c Constraints) String() string {
+	if c.IsZero() {
+		return "none"
+	}
+	return fmt.Sprintf("exclude=%v allow=%v forbid=%v max_legs=%d min_connection=%s",
+		c.ExcludedLocations, c.AllowedVoyages, c.ForbiddenVoyages, c.MaxLegs, c.MinConnectionTime)
+}
+
+// allowsLocation reports whether cargo may be loaded or unloaded at l.
+func (c Constraints) allowsLocation(l location.UNLcode) bool {
+	for _, e := range c.ExcludedLocations {
+		if e == l {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsVoyage reports whether v may be used.
+func (c Constraints) allowsVoyage(v voyage.Number) bool {
+	for _, f := range c.ForbiddenVoyages {
+		if f == v {
+			return false
+		}
+	}
+	if len(c.AllowedVoyages) == 0 {
+		return true
+	}
+	for _, a := range c.AllowedVoyages {
+		if a == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows reports whether the itinerary satisfies the constraints.
+func (c Constraints) Allows(i cargo.Itinerary) bool {
+	if c.MaxLegs > 0 && len(i.Legs) > c.MaxLegs {
+		return false
+	}
+	for k, l := range i.Legs {
+		if !c.allowsVoyage(l.VoyageNumber) || !c.allowsLocation(l.LoadLocation) || !c.allowsLocation(l.UnLoadLocation) {
+			return false
+		}
+		if k > 0 && l.LoadTime.Sub(i.Legs[k-1].UnLoadTime) < c.MinConnectionTime {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns the itineraries that satisfy the constraints.
+func (c Constraints) Filter(itineraries []cargo.Itinerary) []cargo.Itinerary {
+	result := make([]cargo.Itinerary, 0, len(itineraries))
+	for _, i := range itineraries {
+		if c.Allows(i) {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// query parameters of the constraints
+const (
+	excludeParam       = "exclude"
+	allowVoyagesParam  = "allow_voyages"
+	forbidVoyagesParam = "forbid_voyages"
+	maxLegsParam       = "max_legs"
+	minConnectionParam = "min_connection"
+)
+
+// ParseConstraints reads constraints from query parameters: exclude,
+// allow_voyages and forbid_voyages as comma-separated lists, max_legs as a
+// number and min_connection as a duration such as 6h.
+func ParseConstraints(q url.Values) (Constraints, error) {
+	var c Constraints
+	for _, l := range splitList(q.Get(excludeParam)) {
+		c.ExcludedLocations = append(c.ExcludedLocations, location.UNLcode(l))
+	}
+	for _, v := range splitList(q.Get(allowVoyagesParam)) {
+		c.AllowedVoyages = append(c.AllowedVoyages, voyage.Number(v))
+	}
+	for _, v := range splitList(q.Get(forbidVoyagesParam)) {
+		c.ForbiddenVoyages = append(c.ForbiddenVoyages, voyage.Number(v))
+	}
+	if s := q.Get(maxLegsParam); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return Constraints{}, ErrInvalidArgument
+		}
+		c.MaxLegs = n
+	}
+	if s := q.Get(minConnectionParam); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil || d < 0 {
+			return Constraints{}, ErrInvalidArgument
+		}
+		c.MinConnectionTime = d
+	}
+	return c, nil
+}
+
+// encode adds the constraints to query parameters, see ParseConstraints.
+func (c Constraints) encode(q url.Values) {
+	if len(c.ExcludedLocations) > 0 {
+		s := make([]string, len(c.ExcludedLocations))
+		for i, l := range c.ExcludedLocations {
+			s[i] = string(l)
+		}
+		q.Set(excludeParam, strings.Join(s, ","))
+	}
+	if len(c.AllowedVoyages) > 0 {
+		q.Set(allowVoyagesParam, joinVoyages(c.AllowedVoyages))
+	}
+	if len(c.ForbiddenVoyages) > 0 {
+		q.Set(forbidVoyagesParam, joinVoyages(c.ForbiddenVoyages))
+	}
+	if c.MaxLegs > 0 {
+		q.Set(maxLegsParam, strconv.Itoa(c.MaxLegs))
+	}
+	if c.MinConnectionTime > 0 {
+		q.Set(minConnectionParam, c.MinConnectionTime.String())
+	}
+}
+
+func joinVoyages(vs []voyage.Number) string {
+	s := make([]string, len(vs))
+	for i, v := range vs {
+		s[i] = string(v)
+	}
+	return strings.Join(s, ",")
+}
+
+func splitList(s string) []string {
+	var result []string
+	for _, f := range strings.Split(s, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			result = append(result, f)
+		}
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package routing
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+func TestConstraintsAllows(t *testing.T) {
+	// Two legs via BBBBB, connecting after three hours.
+	i := cargo.Itinerary{Legs: []cargo.Leg{
+		cargo.NewLeg("V1", "AAAAA", "BBBBB", at(0), at(5)),
+		cargo.NewLeg("V2", "BBBBB", "CCCCC", at(8), at(12)),
+	}}
+
+	for _, tt := range []struct {
+		name string
+		c    Constraints
+		want bool
+	}{
+		{name: "none", want: true},
+		{name: "excluded transshipment location", c: Constraints{ExcludedLocations: []location.UNLcode{"BBBBB"}}, want: false},
+		{name: "excluded destination", c: Constraints{ExcludedLocations: []location.UNLcode{"CCCCC"}}, want: false},
+		{name: "excluded other location", c: Constraints{ExcludedLocations: []location.UNLcode{"DDDDD"}}, want: true},
+		{name: "allowed voyages", c: Constraints{AllowedVoyages: []voyage.Number{"V1", "V2"}}, want: true},
+		{name: "voyage not allowed", c: Constraints{AllowedVoyages: []voyage.Number{"V1"}}, want: false},
+		{name: "forbidden voyage", c: Constraints{ForbiddenVoyages: []voyage.Number{"V2"}}, want: false},
+		{name: "forbidden over allowed", c: Constraints{AllowedVoyages: []voyage.Number{"V1", "V2"}, ForbiddenVoyages: []voyage.Number{"V1"}}, want: false},
+		{name: "max legs", c: Constraints{MaxLegs: 2}, want: true},
+		{name: "too many legs", c: Constraints{MaxLegs: 1}, want: false},
+		{name: "connection time", c: Constraints{MinConnectionTime: 3 * time.Hour}, want: true},
+		{name: "connection too short", c: Constraints{MinConnectionTime: 4 * time.Hour}, want: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.c.Allows(i); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			if got := len(tt.c.Filter([]cargo.Itinerary{i})) == 1; got != tt.want {
+				t.Errorf("filtered: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConstraints(t *testing.T) {
+	for _, tt := range []struct {
+		query string
+		want  Constraints
+		err   error
+	}{
+		{query: "", want: Constraints{}},
+		{
+			query: "exclude=BBBBB,%20CCCCC&allow_voyages=V1,V2&forbid_voyages=V3&max_legs=3&min_connection=6h",
+			want: Constraints{
+				ExcludedLocations: []location.UNLcode{"BBBBB", "CCCCC"},
+				AllowedVoyages:    []voyage.Number{"V1", "V2"},
+				ForbiddenVoyages:  []voyage.Number{"V3"},
+				MaxLegs:           3,
+				MinConnectionTime: 6 * time.Hour,
+			},
+		},
+		{query: "exclude=,,", want: Constraints{}},
+		{query: "max_legs=many", err: ErrInvalidArgument},
+		{query: "max_legs=-1", err: ErrInvalidArgument},
+		{query: "min_connection=soon", err: ErrInvalidArgument},
+		{query: "min_connection=-1h", err: ErrInvalidArgument},
+	} {
+		q, err := url.ParseQuery(tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := ParseConstraints(q)
+		if err != tt.err || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseConstraints(%q): got %+v and %v, want %+v and %v", tt.query, got, err, tt.want, tt.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		// Constraints survive being sent to a routing service.
+		vals := url.Values{}
+		got.encode(vals)
+		again, err := ParseConstraints(vals)
+		if err != nil || !reflect.DeepEqual(again, got) {
+			t.Errorf("encoding %+v: got %+v and %v back", got, again, err)
+		}
+		if got.IsZero() != (tt.query == "" || tt.query == "exclude=,,") {
+			t.Errorf("%+v: IsZero is %v", got, got.IsZero())
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+)
+
+// MakeHandler returns a handler serving the paths protocol spoken by the
+// proxying middleware, so that a routing service can stand in for the
+// external pathfinder.
+func MakeHandler(s Service, logger kitlog.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(encodeError),
+	}
+
+	findPathsHandler := kithttp.NewServer(
+		makeFindPathsEndpoint(s),
+		decodeFindPathsRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	r := mux.NewRouter()
+
+	r.Handle("/paths", findPathsHandler).Methods("GET")
+
+	return r
+}
+
+func makeFindPathsEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(fetchRoutesRequest)
+		itineraries, err := s.FetchRoutesForSpecification(cargo.RouteSpecification{
+			Origin:      location.UNLcode(req.From),
+			Destination: location.UNLcode(req.To),
+		}, req.Constraints)
+		if err != nil {
+			return nil, err
+		}
+
+		paths := make([]path, 0, len(itineraries))
+		for _, i := range itineraries {
+			edges := make([]edge, 0, len(i.Legs))
+			for _, l := range i.Legs {
+				edges = append(edges, edge{
+					Origin:      string(l.LoadLocation),
+					Destination: string(l.UnLoadLocation),
+					Voyage:      string(l.VoyageNumber),
+					Departure:   l.LoadTime,
+					Arrival:     l.UnLoadTime,
+				})
+			}
+			paths = append(paths, path{Edges: edges})
+		}
+		return fetchRoutesResponse{Paths: paths}, nil
+	}
+}
+
+func decodeFindPathsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	from, to := q.Get("from"), q.Get("to")
+	if from == "" || to == "" {
+		return nil, ErrInvalidArgument
+	}
+	c, err := ParseConstraints(q)
+	if err != nil {
+		return nil, err
+	}
+	return fetchRoutesRequest{From: from, To: to, Constraints: c}, nil
+}
+
+func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
+
+func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch {
+	case err == ErrInvalidArgument:
+		w.WriteHeader(http.StatusBadRequest)
+	case IsUnavailable(err):
+		w.WriteHeader(http.StatusServiceUnavailable)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
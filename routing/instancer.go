@@ -0,0 +1,121 @@
+package routing
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+)
+
+// FileInstancer yields the routing service instances listed in a file, one
+// URL per line, and watches the file for changes. Blank lines and lines
+// starting with # are ignored.
+type FileInstancer struct {
+	path   string
+	logger log.Logger
+
+	mtx   sync.Mutex
+	state sd.Event
+	subs  map[chan<- sd.Event]struct{}
+
+	quit chan struct{}
+	once sync.Once
+}
+
+// NewFileInstancer returns an instancer reading the file at path, which is
+// checked for changes at the given interval.
+func NewFileInstancer(path string, interval time.Duration, logger log.Logger) *FileInstancer {
+	f := &FileInstancer{
+		path:   path,
+		logger: logger,
+		subs:   make(map[chan<- sd.Event]struct{}),
+		quit:   make(chan struct{}),
+	}
+	f.state = f.read()
+	go f.loop(interval)
+	return f
+}
+
+func (f *FileInstancer) loop(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			f.update(f.read())
+		case <-f.quit:
+			return
+		}
+	}
+}
+
+func (f *FileInstancer) read() sd.Event {
+	b, err := ioutil.ReadFile(f.path)
+	if err != nil {
+		f.logger.Log("path", f.path, "err", err)
+		return sd.Event{Err: err}
+	}
+	var instances []string
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		instances = append(instances, line)
+	}
+	return sd.Event{Instances: instances}
+}
+
+func (f *FileInstancer) update(e sd.Event) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if reflect.DeepEqual(f.state, e) {
+		return
+	}
+	if e.Err == nil {
+		f.logger.Log("path", f.path, "instances", strings.Join(e.Instances, ","))
+	}
+	f.state = e
+	for ch := range f.subs {
+		ch <- e
+	}
+}
+
+// Register implements sd.Instancer.
+func (f *FileInstancer) Register(ch chan<- sd.Event) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.subs[ch] = struct{}{}
+	ch <- f.state
+}
+
+// Deregister implements sd.Instancer.
+func (f *FileInstancer) Deregister(ch chan<- sd.Event) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	delete(f.subs, ch)
+}
+
+// Stop implements sd.Instancer.
+func (f *FileInstancer) Stop() {
+	f.once.Do(func() { close(f.quit) })
+}
+
+// SplitInstances splits a comma-separated list of instance URLs, ignoring
+// surrounding whitespace and empty entries.
+func SplitInstances(s string) []string {
+	var instances []string
+	for _, instance := range strings.Split(s, ",") {
+		if instance = strings.TrimSpace(instance); instance != "" {
+			instances = append(instances, instance)
+		}
+	}
+	return instances
+}
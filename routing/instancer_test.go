@@ -0,0 +1,73 @@
+package routing
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+)
+
+func TestSplitInstances(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want []string
+	}{
+		{in: "", want: nil},
+		{in: " ", want: nil},
+		{in: ",", want: nil},
+		{in: " , \t,", want: nil},
+		{in: "http://a:7878", want: []string{"http://a:7878"}},
+		{in: " http://a:7878 ,, http://b:7878 , ", want: []string{"http://a:7878", "http://b:7878"}},
+	} {
+		if got := SplitInstances(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SplitInstances(%q): got %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFileInstancer(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "instances")
+	write := func(s string) {
+		if err := os.WriteFile(path, []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("# routing services\nhttp://a:7878\n\n  http://b:7878  \n")
+
+	f := NewFileInstancer(path, 10*time.Millisecond, log.NewNopLogger())
+	defer f.Stop()
+
+	ch := make(chan sd.Event, 1)
+	f.Register(ch)
+	defer f.Deregister(ch)
+
+	if e := <-ch; e.Err != nil || !reflect.DeepEqual(e.Instances, []string{"http://a:7878", "http://b:7878"}) {
+		t.Fatalf("got %+v, want both instances", e)
+	}
+
+	write("http://c:7878\n")
+
+	select {
+	case e := <-ch:
+		if e.Err != nil || !reflect.DeepEqual(e.Instances, []string{"http://c:7878"}) {
+			t.Errorf("got %+v, want the updated instance", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no update after the file changed")
+	}
+
+	os.Remove(path)
+
+	select {
+	case e := <-ch:
+		if e.Err == nil {
+			t.Errorf("got %+v, want an error for a missing file", e)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no update after the file was removed")
+	}
+}
@@ -0,0 +1,35 @@
+package routing
+
+import (
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/Qalifah/shipping/cargo"
+)
+
+type loggingService struct {
+	logger log.Logger
+	Service
+}
+
+// NewLoggingService returns a new instance of a logging Service.
+func NewLoggingService(logger log.Logger, s Service) Service {
+	return &loggingService{logger, s}
+}
+
+func (s *loggingService) FetchRoutesForSpecification(rs cargo.RouteSpecification, c Constraints) (itineraries []cargo.Itinerary, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "fetch_routes_for_specification",
+			"origin", rs.Origin,
+			"destination", rs.Destination,
+			"arrival_deadline", rs.Deadline,
+			"constraints", c,
+			"routes", len(itineraries),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.FetchRoutesForSpecification(rs, c)
+}
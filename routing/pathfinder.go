@@ -0,0 +1,153 @@
+package routing
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+// maxLegs bounds the number of legs of the itineraries found by the
+// pathfinder, which also bounds the search.
+const maxLegs = 4
+
+// departure is a node of the time-expanded graph: a voyage leaving a
+// location at a point in time. Cargo can board at any departure, ride the
+// voyage through any number of its following movements and unload at the
+// arrival of the last one.
+type departure struct {
+	voyage *voyage.Voyage
+	index  int
+}
+
+func (d departure) movement() voyage.CarrierMovement {
+	return d.voyage.Schedule.CarrierMovements[d.index]
+}
+
+// graph indexes the departures of every voyage by location, ordered by
+// departure time. Waiting at a location is implicit in that order.
+type graph map[location.UNLcode][]departure
+
+func newGraph(voyages []*voyage.Voyage) graph {
+	g := make(graph)
+	for _, v := range voyages {
+		for i, m := range v.Schedule.CarrierMovements {
+			g[m.DepartureLocation] = append(g[m.DepartureLocation], departure{voyage: v, index: i})
+		}
+	}
+	for _, ds := range g {
+		sort.Slice(ds, func(i, j int) bool {
+			return ds[i].movement().DepartureTime.Before(ds[j].movement().DepartureTime)
+		})
+	}
+	return g
+}
+
+type pathfinder struct {
+	voyages voyage.Repository
+	now     func() time.Time
+}
+
+// NewPathfinder returns a routing service that finds itineraries in-process,
+// from the schedules of the voyages in the repository. Itineraries depart no
+// earlier than now, never visit a location twice, satisfy the constraints
+// and, if the specification has a deadline, arrive before it. They are
+// ordered by arrival time.
+func NewPathfinder(voyages voyage.Repository) Service {
+	return &pathfinder{voyages: voyages, now: time.Now}
+}
+
+func (p *pathfinder) FetchRoutesForSpecification(rs cargo.RouteSpecification, c Constraints) ([]cargo.Itinerary, error) {
+	if rs.Origin == "" || rs.Destination == "" || rs.Origin == rs.Destination {
+		return []cargo.Itinerary{}, nil
+	}
+
+	if !c.allowsLocation(rs.Origin) || !c.allowsLocation(rs.Destination) {
+		return []cargo.Itinerary{}, nil
+	}
+
+	limit := maxLegs
+	if c.MaxLegs > 0 && c.MaxLegs < limit {
+		limit = c.MaxLegs
+	}
+
+	s := search{
+		graph:   newGraph(p.voyages.FindAll()),
+		spec:    rs,
+		c:       c,
+		maxLegs: limit,
+		visited: map[location.UNLcode]bool{rs.Origin: true},
+		found:   make([]cargo.Itinerary, 0),
+	}
+	s.from(rs.Origin, p.now(), nil)
+
+	sort.SliceStable(s.found, func(i, j int) bool {
+		return s.found[i].FinalArrivalTime().Before(s.found[j].FinalArrivalTime())
+	})
+	return s.found, nil
+}
+
+// search is a depth-first search for itineraries through the graph.
+type search struct {
+	graph   graph
+	spec    cargo.RouteSpecification
+	c       Constraints
+	maxLegs int
+	visited map[location.UNLcode]bool
+	found   []cargo.Itinerary
+}
+
+func (s *search) from(loc location.UNLcode, ready time.Time, legs []cargo.Leg) {
+	if len(legs) == s.maxLegs {
+		return
+	}
+	if len(legs) > 0 {
+		ready = ready.Add(s.c.MinConnectionTime)
+	}
+
+	for _, d := range s.graph[loc] {
+		if d.movement().DepartureTime.Before(ready) || !s.c.allowsVoyage(d.voyage.Number) {
+			continue
+		}
+		// Unloading and reloading the same voyage is the same as staying
+		// on board.
+		if len(legs) > 0 && legs[len(legs)-1].VoyageNumber == d.voyage.Number {
+			continue
+		}
+
+		movements := d.voyage.Schedule.CarrierMovements
+		for i := d.index; i < len(movements); i++ {
+			// Cancelled movements can leave gaps in a schedule, and cargo
+			// cannot stay on board across them.
+			if i > d.index && movements[i].DepartureLocation != movements[i-1].ArrivalLocation {
+				break
+			}
+			to := movements[i].ArrivalLocation
+			if s.visited[to] {
+				break
+			}
+			if !s.c.allowsLocation(to) {
+				// The cargo can stay on board past an excluded location.
+				continue
+			}
+			arrival := movements[i].ArrivalTime
+			if !s.spec.Deadline.IsZero() && arrival.After(s.spec.Deadline) {
+				break
+			}
+
+			leg := cargo.NewLeg(d.voyage.Number, loc, to, d.movement().DepartureTime, arrival)
+			next := append(legs[:len(legs):len(legs)], leg)
+
+			if to == s.spec.Destination {
+				s.found = append(s.found, cargo.Itinerary{Legs: next})
+				break
+			}
+
+			s.visited[to] = true
+			s.from(to, arrival, next)
+			s.visited[to] = false
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+var t0 = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func at(hours int) time.Time {
+	return t0.Add(time.Duration(hours) * time.Hour)
+}
+
+type voyageRepository []*voyage.Voyage
+
+func (r voyageRepository) Store(*voyage.Voyage) error { return nil }
+
+func (r voyageRepository) Find(n voyage.Number) (*voyage.Voyage, error) {
+	for _, v := range r {
+		if v.Number == n {
+			return v, nil
+		}
+	}
+	return nil, voyage.ErrUnknown
+}
+
+func (r voyageRepository) FindAll() []*voyage.Voyage { return r }
+
+// sailing returns a voyage of a single movement.
+func sailing(n voyage.Number, from, to location.UNLcode, departure, arrival time.Time) *voyage.Voyage {
+	return voyage.New(n, voyage.Schedule{CarrierMovements: []voyage.CarrierMovement{
+		{DepartureLocation: from, ArrivalLocation: to, DepartureTime: departure, ArrivalTime: arrival},
+	}})
+}
+
+func newTestPathfinder(now time.Time, voyages ...*voyage.Voyage) Service {
+	return &pathfinder{voyages: voyageRepository(voyages), now: func() time.Time { return now }}
+}
+
+func voyagesOf(i cargo.Itinerary) []voyage.Number {
+	var vs []voyage.Number
+	for _, l := range i.Legs {
+		vs = append(vs, l.VoyageNumber)
+	}
+	return vs
+}
+
+func TestPathfinderDepartsAfterNow(t *testing.T) {
+	voyages := []*voyage.Voyage{
+		sailing("EARLY", "AAAAA", "BBBBB", at(1), at(2)),
+		sailing("LATE", "AAAAA", "BBBBB", at(5), at(6)),
+	}
+	rs := cargo.RouteSpecification{Origin: "AAAAA", Destination: "BBBBB"}
+
+	for _, tt := range []struct {
+		now  time.Time
+		want int
+	}{
+		{now: at(0), want: 2},
+		{now: at(1), want: 2},
+		{now: at(3), want: 1},
+		{now: at(6), want: 0},
+	} {
+		found, err := newTestPathfinder(tt.now, voyages...).FetchRoutesForSpecification(rs, Constraints{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found) != tt.want {
+			t.Errorf("at %v: got %d itineraries, want %d", tt.now, len(found), tt.want)
+		}
+		for _, i := range found {
+			if i.InitialDepartureTime().Before(tt.now) {
+				t.Errorf("at %v: got an itinerary departing at %v", tt.now, i.InitialDepartureTime())
+			}
+		}
+	}
+}
+
+func TestPathfinderMaxLegs(t *testing.T) {
+	// A chain of five voyages, each a leg further.
+	locations := []location.UNLcode{"AAAAA", "BBBBB", "CCCCC", "DDDDD", "EEEEE", "FFFFF"}
+	var voyages []*voyage.Voyage
+	for i := 0; i < len(locations)-1; i++ {
+		voyages = append(voyages, sailing(voyage.Number(locations[i]), locations[i], locations[i+1], at(10*i+1), at(10*i+2)))
+	}
+	p := newTestPathfinder(t0, voyages...)
+
+	for _, tt := range []struct {
+		destination location.UNLcode
+		c           Constraints
+		want        int
+	}{
+		{destination: "EEEEE", want: 1},
+		{destination: "FFFFF", want: 0},
+		{destination: "CCCCC", c: Constraints{MaxLegs: 2}, want: 1},
+		{destination: "DDDDD", c: Constraints{MaxLegs: 2}, want: 0},
+		// A constraint cannot raise the cap.
+		{destination: "FFFFF", c: Constraints{MaxLegs: 10}, want: 0},
+	} {
+		found, err := p.FetchRoutesForSpecification(cargo.RouteSpecification{Origin: "AAAAA", Destination: tt.destination}, tt.c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(found) != tt.want {
+			t.Errorf("to %v with %v: got %d itineraries, want %d", tt.destination, tt.c, len(found), tt.want)
+		}
+	}
+}
+
+func TestPathfinderDoesNotRevisitLocations(t *testing.T) {
+	p := newTestPathfinder(t0,
+		sailing("OUT", "AAAAA", "BBBBB", at(1), at(2)),
+		sailing("BACK", "BBBBB", "AAAAA", at(3), at(4)),
+		sailing("ON", "AAAAA", "CCCCC", at(5), at(6)),
+		// A round trip through BBBBB on a single voyage.
+		voyage.New("LOOP", voyage.Schedule{CarrierMovements: []voyage.CarrierMovement{
+			{DepartureLocation: "AAAAA", ArrivalLocation: "BBBBB", DepartureTime: at(1), ArrivalTime: at(2)},
+			{DepartureLocation: "BBBBB", ArrivalLocation: "AAAAA", DepartureTime: at(3), ArrivalTime: at(4)},
+			{DepartureLocation: "AAAAA", ArrivalLocation: "CCCCC", DepartureTime: at(7), ArrivalTime: at(8)},
+		}}),
+	)
+
+	found, err := p.FetchRoutesForSpecification(cargo.RouteSpecification{Origin: "AAAAA", Destination: "CCCCC"}, Constraints{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, i := range found {
+		seen := map[location.UNLcode]bool{i.InitialDepartureLocation(): true}
+		for _, l := range i.Legs {
+			if seen[l.UnLoadLocation] {
+				t.Errorf("itinerary %v visits %v twice", voyagesOf(i), l.UnLoadLocation)
+			}
+			seen[l.UnLoadLocation] = true
+		}
+	}
+	// Only the direct sailing and boarding the loop at its last movement.
+	if len(found) != 2 {
+		t.Errorf("got itineraries %v, want 2", found)
+	}
+}
+
+func TestPathfinderDeadline(t *testing.T) {
+	p := newTestPathfinder(t0,
+		sailing("FAST", "AAAAA", "BBBBB", at(1), at(10)),
+		sailing("ONTIME", "AAAAA", "BBBBB", at(1), at(20)),
+		sailing("SLOW", "AAAAA", "BBBBB", at(1), at(30)),
+		sailing("FIRST", "AAAAA", "CCCCC", at(1), at(5)),
+		sailing("SECOND", "CCCCC", "BBBBB", at(6), at(25)),
+	)
+
+	found, err := p.FetchRoutesForSpecification(cargo.RouteSpecification{Origin: "AAAAA", Destination: "BBBBB", Deadline: at(20)}, Constraints{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 2 {
+		t.Fatalf("got itineraries %v, want 2", found)
+	}
+	// Ordered by arrival, and arriving at the deadline is in time.
+	if found[0].Legs[0].VoyageNumber != "FAST" || found[1].Legs[0].VoyageNumber != "ONTIME" {
+		t.Errorf("got %v and %v, want FAST and ONTIME", voyagesOf(found[0]), voyagesOf(found[1]))
+	}
+}
@@ -3,37 +3,49 @@ package routing
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/Qalifah/shipping/cargo"
 	"github.com/Qalifah/shipping/location"
 	"github.com/Qalifah/shipping/voyage"
 
+	"github.com/go-kit/kit/circuitbreaker"
 	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+	"github.com/go-kit/kit/sd/lb"
 	kithttp "github.com/go-kit/kit/transport/http"
-	"github.com/go-kit/kit/circuitbreaker"
+	"github.com/sony/gobreaker"
 )
 
-
 type proxyService struct {
 	context.Context
 	FetchRoutesEndpoint endpoint.Endpoint
+	cache               *routeCache
 	Service
 }
 
-func(s proxyService) FetchRoutesForSpecification(rs cargo.RouteSpecification) []cargo.Itinerary {
-	response, err := s.FetchRoutesEndpoint(s.Context, fetchRoutesRequest{
+func(s proxyService) FetchRoutesForSpecification(rs cargo.RouteSpecification, c Constraints) ([]cargo.Itinerary, error) {
+	req := fetchRoutesRequest{
 		From: string(rs.Origin),
 		To: string(rs.Destination),
-	})
+		Constraints: c,
+	}
+	response, err := s.FetchRoutesEndpoint(s.Context, req)
 	if err != nil {
-		return []cargo.Itinerary{}
+		if itineraries, ok := s.cache.get(req); ok {
+			return itineraries, nil
+		}
+		return nil, &UnavailableError{Err: err}
 	}
 	resp := response.(fetchRoutesResponse)
 
-	var itineraries []cargo.Itinerary
+	itineraries := make([]cargo.Itinerary, 0, len(resp.Paths))
 	for _, r := range resp.Paths {
 		var legs []cargo.Leg
 		for _, e := range r.Edges {
@@ -47,51 +59,193 @@ func(s proxyService) FetchRoutesForSpecification(rs cargo.RouteSpecification) []
 		}
 		itineraries = append(itineraries, cargo.Itinerary{Legs: legs})
 	}
-	return itineraries
+	// The external pathfinder may not understand the constraints.
+	itineraries = c.Filter(itineraries)
+
+	s.cache.put(req, itineraries)
+	return itineraries, nil
 }
 
 // ServiceMiddleware defines a middleware for a routing service
 type ServiceMiddleware	func(Service) Service
 
-// NewProxyingMiddleware returns a new instance of a proxying middleware.
-func NewProxyingMiddleware(ctx context.Context, proxyURL string) ServiceMiddleware {
+type proxyOptions struct {
+	timeout  time.Duration
+	attempts int
+	backoff  time.Duration
+	cacheTTL time.Duration
+}
+
+// ProxyOption sets an optional parameter of the proxying middleware.
+type ProxyOption func(*proxyOptions)
+
+// ProxyTimeout sets the deadline of each call to the routing service. The
+// default is 2 seconds.
+func ProxyTimeout(d time.Duration) ProxyOption {
+	return func(o *proxyOptions) { o.timeout = d }
+}
+
+// ProxyRetries sets how many times a failed call is retried, on the next
+// instance in turn, and the delay before the first retry, which doubles with
+// every further retry. The default is 2 retries, starting at 100
+// milliseconds.
+func ProxyRetries(n int, backoff time.Duration) ProxyOption {
+	return func(o *proxyOptions) { o.attempts, o.backoff = n+1, backoff }
+}
+
+// ProxyCacheTTL sets how long the last good answer for an origin and
+// destination is kept to fall back on while the routing service is
+// unavailable. The default is 10 minutes, zero disables the cache.
+func ProxyCacheTTL(d time.Duration) ProxyOption {
+	return func(o *proxyOptions) { o.cacheTTL = d }
+}
+
+// NewProxyingMiddleware returns a new instance of a proxying middleware. Calls
+// are balanced round-robin over the routing service instances, given as
+// URLs, that the instancer yields. Every instance has its own circuit
+// breaker.
+func NewProxyingMiddleware(ctx context.Context, instancer sd.Instancer, logger log.Logger, options ...ProxyOption) ServiceMiddleware {
+	o := proxyOptions{
+		timeout:  2 * time.Second,
+		attempts: 3,
+		backoff:  100 * time.Millisecond,
+		cacheTTL: 10 * time.Minute,
+	}
+	for _, option := range options {
+		option(&o)
+	}
+
+	factory := func(instance string) (endpoint.Endpoint, io.Closer, error) {
+		e, err := makeFetchRoutesEndpoint(ctx, instance)
+		if err != nil {
+			return nil, nil, err
+		}
+		e = timeout(o.timeout)(e)
+		e = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name: instance,
+		}))(e)
+		return e, nil, nil
+	}
+
 	return func(next Service) Service {
-		var e endpoint.Endpoint
-		e = makeFetchRoutesEndpoint(ctx, proxyURL)
-		e = circuitbreaker.Hystrix("fetch-routes")(e)
-		return proxyService{ctx, e, next}
+		endpointer := sd.NewEndpointer(instancer, factory, logger)
+		balancer := lb.NewRoundRobin(endpointer)
+
+		// The overall deadline leaves room for every attempt and backoff.
+		deadline := time.Duration(o.attempts)*o.timeout + (1<<uint(o.attempts))*o.backoff
+		e := lb.RetryWithCallback(deadline, balancer, retry(o.attempts, o.backoff))
+
+		return proxyService{ctx, e, newRouteCache(o.cacheTTL), next}
+	}
+}
+
+// timeout bounds every call to the next endpoint by a deadline.
+func timeout(d time.Duration) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (interface{}, error) {
+			ctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+			return next(ctx, request)
+		}
+	}
+}
+
+// retry allows up to attempts calls, backing off exponentially between
+// them. There is no point in retrying when there are no instances at all.
+func retry(attempts int, backoff time.Duration) lb.Callback {
+	return func(n int, err error) (bool, error) {
+		if n >= attempts || err == lb.ErrNoEndpoints {
+			return false, nil
+		}
+		time.Sleep(backoff << uint(n-1))
+		return true, nil
+	}
+}
+
+type cacheEntry struct {
+	itineraries []cargo.Itinerary
+	expires     time.Time
+}
+
+// routeCache keeps the last good answer per request.
+type routeCache struct {
+	ttl     time.Duration
+	mtx     sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func newRouteCache(ttl time.Duration) *routeCache {
+	return &routeCache{ttl: ttl, entries: make(map[string]cacheEntry)}
+}
+
+func (c *routeCache) key(req fetchRoutesRequest) string {
+	vals := url.Values{}
+	req.Constraints.encode(vals)
+	return req.From + "|" + req.To + "|" + vals.Encode()
+}
+
+func (c *routeCache) put(req fetchRoutesRequest, itineraries []cargo.Itinerary) {
+	if c.ttl <= 0 {
+		return
 	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	now := time.Now()
+	for k, e := range c.entries {
+		if now.After(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[c.key(req)] = cacheEntry{itineraries: itineraries, expires: now.Add(c.ttl)}
+}
+
+func (c *routeCache) get(req fetchRoutesRequest) ([]cargo.Itinerary, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	e, ok := c.entries[c.key(req)]
+	if !ok || time.Now().After(e.expires) {
+		return nil, false
+	}
+	return e.itineraries, true
 }
 
 type fetchRoutesRequest struct {
 	From	string
 	To		string
+	Constraints	Constraints
 }
 
 type fetchRoutesResponse struct {
-	Paths	[]struct {
-		Edges	[]struct {
-			Origin		string	`json:"origin"`
-			Destination		string	`json:"destination"`
-			Voyage		string		`json:"voyage"`
-			Departure	time.Time	`json:"departure"`
-			Arrival		time.Time	`json:"arrival"`
-		}	`json:"edges"`
-	}	`json:"paths"`
-}
-
-func makeFetchRoutesEndpoint(ctx context.Context, instance string) endpoint.Endpoint {
+	Paths	[]path	`json:"paths"`
+}
+
+type path struct {
+	Edges	[]edge	`json:"edges"`
+}
+
+type edge struct {
+	Origin		string	`json:"origin"`
+	Destination		string	`json:"destination"`
+	Voyage		string		`json:"voyage"`
+	Departure	time.Time	`json:"departure"`
+	Arrival		time.Time	`json:"arrival"`
+}
+
+func makeFetchRoutesEndpoint(ctx context.Context, instance string) (endpoint.Endpoint, error) {
 	u, err := url.Parse(instance)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	if u.Path == "" {
 		u.Path = "/paths"
 	}
-	return kithttp.NewClient("GET", u, encodeFetchRoutesRequest, decodeFetchRoutesResponse).Endpoint()
+	return kithttp.NewClient("GET", u, encodeFetchRoutesRequest, decodeFetchRoutesResponse).Endpoint(), nil
 }
 
 func decodeFetchRoutesResponse(_ context.Context, resp *http.Response) (interface{}, error) {
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
 	var response fetchRoutesResponse
 	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
 		return nil, err
@@ -105,7 +259,8 @@ func encodeFetchRoutesRequest(_ context.Context, r *http.Request, request interf
 	vals := r.URL.Query()
 	vals.Add("from", req.From)
 	vals.Add("to", req.To)
+	req.Constraints.encode(vals)
 	r.URL.RawQuery = vals.Encode()
 
 	return nil
-}
\ No newline at end of file
+}
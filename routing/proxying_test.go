@@ -0,0 +1,152 @@
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/sd"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+var proxyRS = cargo.RouteSpecification{Origin: "AAAAA", Destination: "BBBBB"}
+
+// pathsServer serves a single direct path, after failing the given number of
+// requests. It counts the requests it received.
+func pathsServer(t *testing.T, failures int32) (*httptest.Server, *int32) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if n := atomic.AddInt32(&calls, 1); n <= failures {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(fetchRoutesResponse{Paths: []path{{Edges: []edge{{
+			Origin:      r.URL.Query().Get("from"),
+			Destination: r.URL.Query().Get("to"),
+			Voyage:      "V1",
+			Departure:   at(1),
+			Arrival:     at(2),
+		}}}}})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+func newTestProxy(instances []string, options ...ProxyOption) Service {
+	return NewProxyingMiddleware(context.Background(), sd.FixedInstancer(instances), log.NewNopLogger(), options...)(nil)
+}
+
+func TestProxyRetries(t *testing.T) {
+	srv, calls := pathsServer(t, 2)
+	s := newTestProxy([]string{srv.URL}, ProxyRetries(2, time.Millisecond))
+
+	itineraries, err := s.FetchRoutesForSpecification(proxyRS, Constraints{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(itineraries) != 1 || itineraries[0].Legs[0].VoyageNumber != "V1" {
+		t.Errorf("got %+v, want the path over V1", itineraries)
+	}
+	if n := atomic.LoadInt32(calls); n != 3 {
+		t.Errorf("got %d calls, want 3", n)
+	}
+}
+
+func TestProxyGivesUpAfterRetries(t *testing.T) {
+	srv, calls := pathsServer(t, 3)
+	s := newTestProxy([]string{srv.URL}, ProxyRetries(2, time.Millisecond), ProxyCacheTTL(0))
+
+	if _, err := s.FetchRoutesForSpecification(proxyRS, Constraints{}); !IsUnavailable(err) {
+		t.Errorf("got %v, want the routing service to be unavailable", err)
+	}
+	if n := atomic.LoadInt32(calls); n != 3 {
+		t.Errorf("got %d calls, want 3", n)
+	}
+}
+
+func TestProxyTimeout(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	s := newTestProxy([]string{srv.URL}, ProxyTimeout(20*time.Millisecond), ProxyRetries(0, 0))
+
+	begin := time.Now()
+	if _, err := s.FetchRoutesForSpecification(proxyRS, Constraints{}); !IsUnavailable(err) {
+		t.Errorf("got %v, want the routing service to be unavailable", err)
+	}
+	if took := time.Since(begin); took > time.Second {
+		t.Errorf("took %v despite the timeout", took)
+	}
+}
+
+func TestProxyFallsBackOnCache(t *testing.T) {
+	srv, _ := pathsServer(t, 0)
+	s := newTestProxy([]string{srv.URL}, ProxyRetries(1, time.Millisecond))
+
+	want, err := s.FetchRoutesForSpecification(proxyRS, Constraints{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv.Close()
+
+	got, err := s.FetchRoutesForSpecification(proxyRS, Constraints{})
+	if err != nil {
+		t.Fatalf("got %v, want the cached routes", err)
+	}
+	if len(got) != len(want) || got[0].Legs[0].VoyageNumber != want[0].Legs[0].VoyageNumber {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	// Nothing is cached for other requests.
+	other := cargo.RouteSpecification{Origin: "AAAAA", Destination: "CCCCC"}
+	if _, err := s.FetchRoutesForSpecification(other, Constraints{}); !IsUnavailable(err) {
+		t.Errorf("got %v, want the routing service to be unavailable", err)
+	}
+	if _, err := s.FetchRoutesForSpecification(proxyRS, Constraints{MaxLegs: 1}); !IsUnavailable(err) {
+		t.Errorf("got %v for other constraints, want the routing service to be unavailable", err)
+	}
+}
+
+func TestProxyCircuitBreaker(t *testing.T) {
+	srv, calls := pathsServer(t, 1000)
+	s := newTestProxy([]string{srv.URL}, ProxyRetries(0, 0), ProxyCacheTTL(0))
+
+	// The breaker of the instance opens after more than five consecutive
+	// failures, after which the instance is no longer called.
+	for i := 0; i < 10; i++ {
+		if _, err := s.FetchRoutesForSpecification(proxyRS, Constraints{}); !IsUnavailable(err) {
+			t.Fatalf("call %d: got %v, want the routing service to be unavailable", i, err)
+		}
+	}
+	if n := atomic.LoadInt32(calls); n != 6 {
+		t.Errorf("got %d calls, want 6", n)
+	}
+}
+
+func TestProxyFiltersByConstraints(t *testing.T) {
+	srv, _ := pathsServer(t, 0)
+	s := newTestProxy([]string{srv.URL})
+
+	itineraries, err := s.FetchRoutesForSpecification(proxyRS, Constraints{ForbiddenVoyages: []voyage.Number{"V1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(itineraries) != 0 {
+		t.Errorf("got %+v, want none over a forbidden voyage", itineraries)
+	}
+}
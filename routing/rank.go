@@ -0,0 +1,120 @@
+package routing
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Qalifah/shipping/cargo"
+)
+
+// Criterion is a property itineraries can be ranked by. Itineraries ranked
+// by a criterion are ordered from the best to the worst.
+type Criterion string
+
+// valid ranking criteria
+const (
+	// ByArrival prefers the earliest final arrival.
+	ByArrival Criterion = "arrival"
+
+	// ByTransshipments prefers the fewest changes of voyage.
+	ByTransshipments Criterion = "transshipments"
+
+	// ByTransitTime prefers the shortest time from initial departure to
+	// final arrival.
+	ByTransitTime Criterion = "transit"
+)
+
+// DefaultCriteria rank itineraries when no criteria are given.
+var DefaultCriteria = []Criterion{ByArrival, ByTransshipments, ByTransitTime}
+
+// ParseCriteria parses a comma-separated list of criteria. An empty list
+// yields the DefaultCriteria.
+func ParseCriteria(s string) ([]Criterion, error) {
+	if strings.TrimSpace(s) == "" {
+		return DefaultCriteria, nil
+	}
+	var criteria []Criterion
+	for _, f := range strings.Split(s, ",") {
+		c := Criterion(strings.TrimSpace(f))
+		switch c {
+		case ByArrival, ByTransshipments, ByTransitTime:
+			criteria = append(criteria, c)
+		default:
+			return nil, ErrInvalidArgument
+		}
+	}
+	return criteria, nil
+}
+
+func (c Criterion) compare(a, b cargo.Itinerary) int {
+	switch c {
+	case ByArrival:
+		return compareTimes(a.FinalArrivalTime(), b.FinalArrivalTime())
+	case ByTransshipments:
+		return len(a.Legs) - len(b.Legs)
+	case ByTransitTime:
+		return compareDurations(transitTime(a), transitTime(b))
+	}
+	return 0
+}
+
+func transitTime(i cargo.Itinerary) time.Duration {
+	return i.FinalArrivalTime().Sub(i.InitialDepartureTime())
+}
+
+func compareTimes(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
+
+func compareDurations(a, b time.Duration) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Rank orders itineraries by the first criterion, breaking ties with the
+// following ones. Itineraries without legs are ranked last.
+func Rank(itineraries []cargo.Itinerary, criteria ...Criterion) {
+	if len(criteria) == 0 {
+		criteria = DefaultCriteria
+	}
+	sort.SliceStable(itineraries, func(i, j int) bool {
+		a, b := itineraries[i], itineraries[j]
+		if a.IsEmpty() || b.IsEmpty() {
+			return !a.IsEmpty() && b.IsEmpty()
+		}
+		for _, c := range criteria {
+			if d := c.compare(a, b); d != 0 {
+				return d < 0
+			}
+		}
+		return false
+	})
+}
+
+// MeetingDeadline returns the itineraries that satisfy the specification
+// and arrive no later than its deadline, if it has one.
+func MeetingDeadline(rs cargo.RouteSpecification, itineraries []cargo.Itinerary) []cargo.Itinerary {
+	result := make([]cargo.Itinerary, 0, len(itineraries))
+	for _, i := range itineraries {
+		if !rs.IsSatisfiedBy(i) || i.IsEmpty() {
+			continue
+		}
+		if !rs.Deadline.IsZero() && i.FinalArrivalTime().After(rs.Deadline) {
+			continue
+		}
+		result = append(result, i)
+	}
+	return result
+}
@@ -0,0 +1,120 @@
+package routing
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+// itinerary returns an itinerary from AAAAA to ZZZZZ, departing and
+// arriving at the given hours, with the given number of legs.
+func itinerary(n voyage.Number, departure, arrival, legs int) cargo.Itinerary {
+	if legs == 1 {
+		return cargo.Itinerary{Legs: []cargo.Leg{cargo.NewLeg(n, "AAAAA", "ZZZZZ", at(departure), at(arrival))}}
+	}
+	i := cargo.Itinerary{Legs: []cargo.Leg{cargo.NewLeg(n, "AAAAA", "BBBBB", at(departure), at(departure+1))}}
+	for k := 1; k < legs-1; k++ {
+		i.Legs = append(i.Legs, cargo.NewLeg(n, "BBBBB", "BBBBB", at(departure+1), at(departure+1)))
+	}
+	i.Legs = append(i.Legs, cargo.NewLeg(n, "BBBBB", "ZZZZZ", at(departure+1), at(arrival)))
+	return i
+}
+
+func TestRank(t *testing.T) {
+	var (
+		// Arrives first, with a long transit and two transshipments.
+		early = itinerary("EARLY", 0, 10, 3)
+		// Arrives last, but is direct.
+		direct = itinerary("DIRECT", 10, 30, 1)
+		// The shortest transit, with one transshipment.
+		quick = itinerary("QUICK", 18, 20, 2)
+	)
+
+	for _, tt := range []struct {
+		criteria []Criterion
+		want     []voyage.Number
+	}{
+		{criteria: []Criterion{ByArrival}, want: []voyage.Number{"EARLY", "QUICK", "DIRECT"}},
+		{criteria: []Criterion{ByTransshipments}, want: []voyage.Number{"DIRECT", "QUICK", "EARLY"}},
+		{criteria: []Criterion{ByTransitTime}, want: []voyage.Number{"QUICK", "EARLY", "DIRECT"}},
+		{criteria: nil, want: []voyage.Number{"EARLY", "QUICK", "DIRECT"}},
+	} {
+		itineraries := []cargo.Itinerary{direct, {}, early, quick}
+		Rank(itineraries, tt.criteria...)
+
+		var got []voyage.Number
+		for _, i := range itineraries[:3] {
+			got = append(got, i.Legs[0].VoyageNumber)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ranked by %v: got %v, want %v", tt.criteria, got, tt.want)
+		}
+		if !itineraries[3].IsEmpty() {
+			t.Errorf("ranked by %v: empty itinerary not last", tt.criteria)
+		}
+	}
+}
+
+func TestRankBreaksTies(t *testing.T) {
+	var (
+		direct  = itinerary("DIRECT", 5, 20, 1)
+		onestop = itinerary("ONESTOP", 10, 20, 2)
+		longer  = itinerary("LONGER", 0, 20, 2)
+	)
+	itineraries := []cargo.Itinerary{longer, onestop, direct}
+	Rank(itineraries, ByArrival, ByTransshipments, ByTransitTime)
+
+	want := []voyage.Number{"DIRECT", "ONESTOP", "LONGER"}
+	for i, n := range want {
+		if itineraries[i].Legs[0].VoyageNumber != n {
+			t.Errorf("got %v at %d, want %v", itineraries[i].Legs[0].VoyageNumber, i, n)
+		}
+	}
+}
+
+func TestParseCriteria(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want []Criterion
+		err  error
+	}{
+		{in: "", want: DefaultCriteria},
+		{in: " transit , arrival", want: []Criterion{ByTransitTime, ByArrival}},
+		{in: "transshipments", want: []Criterion{ByTransshipments}},
+		{in: "arrival,cheapest", err: ErrInvalidArgument},
+	} {
+		got, err := ParseCriteria(tt.in)
+		if err != tt.err || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseCriteria(%q): got %v and %v, want %v and %v", tt.in, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestMeetingDeadline(t *testing.T) {
+	var (
+		early = itinerary("EARLY", 0, 10, 1)
+		due   = itinerary("DUE", 0, 20, 1)
+		late  = itinerary("LATE", 0, 30, 1)
+		wrong = cargo.Itinerary{Legs: []cargo.Leg{cargo.NewLeg("WRONG", "AAAAA", "YYYYY", at(0), at(5))}}
+	)
+	itineraries := []cargo.Itinerary{early, due, late, wrong, {}}
+
+	for _, tt := range []struct {
+		rs   cargo.RouteSpecification
+		want []voyage.Number
+	}{
+		{rs: cargo.RouteSpecification{Origin: "AAAAA", Destination: "ZZZZZ", Deadline: at(20)}, want: []voyage.Number{"EARLY", "DUE"}},
+		{rs: cargo.RouteSpecification{Origin: "AAAAA", Destination: "ZZZZZ"}, want: []voyage.Number{"EARLY", "DUE", "LATE"}},
+		{rs: cargo.RouteSpecification{Origin: "AAAAA", Destination: "YYYYY", Deadline: at(1)}, want: nil},
+	} {
+		var got []voyage.Number
+		for _, i := range MeetingDeadline(tt.rs, itineraries) {
+			got = append(got, i.Legs[0].VoyageNumber)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("meeting %+v: got %v, want %v", tt.rs, got, tt.want)
+		}
+	}
+}
@@ -1,12 +1,39 @@
 package routing
 
 import (
+	"errors"
+
 	"github.com/Qalifah/shipping/cargo"
 )
 
-// Service provides access to an external routing service.
+// ErrInvalidArgument is returned when one or more arguments are invalid.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// Service provides access to a routing service, either an external one
+// reached through a proxy or the in-process pathfinder.
 type Service interface {
 	// FetchRoutesForSpecification finds all possible routes that satisfy a
-	// given specification.
-	FetchRoutesForSpecification(rs cargo.RouteSpecification) []cargo.Itinerary
+	// given specification and constraints. It fails with an
+	// *UnavailableError if the routes cannot be looked up at the moment.
+	FetchRoutesForSpecification(rs cargo.RouteSpecification, c Constraints) ([]cargo.Itinerary, error)
+}
+
+// UnavailableError is returned when the routing service cannot be reached,
+// as opposed to there being no routes.
+type UnavailableError struct {
+	Err error
+}
+
+func (e *UnavailableError) Error() string {
+	return "routing service unavailable: " + e.Err.Error()
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// IsUnavailable reports whether err is, or wraps, an *UnavailableError.
+func IsUnavailable(err error) bool {
+	var e *UnavailableError
+	return errors.As(err, &e)
 }
\ No newline at end of file
@@ -0,0 +1,323 @@
+package scheduling
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/go-kit/kit/circuitbreaker"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/ratelimit"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+
+	"github.com/Qalifah/shipping/voyage"
+
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/sony/gobreaker"
+)
+
+type createVoyageRequest struct {
+	Number    voyage.Number
+	Movements []voyage.CarrierMovement
+}
+
+type createVoyageResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r createVoyageResponse) error() error { return r.Err }
+
+func makeCreateVoyageEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createVoyageRequest)
+		err := s.CreateVoyage(req.Number, req.Movements)
+		return createVoyageResponse{Err: err}, nil
+	}
+}
+
+type addMovementRequest struct {
+	Number   voyage.Number
+	Movement voyage.CarrierMovement
+}
+
+type addMovementResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r addMovementResponse) error() error { return r.Err }
+
+func makeAddMovementEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(addMovementRequest)
+		err := s.AddCarrierMovement(req.Number, req.Movement)
+		return addMovementResponse{Err: err}, nil
+	}
+}
+
+type modifyMovementRequest struct {
+	Number   voyage.Number
+	Index    int
+	Movement voyage.CarrierMovement
+}
+
+type modifyMovementResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r modifyMovementResponse) error() error { return r.Err }
+
+func makeModifyMovementEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(modifyMovementRequest)
+		err := s.ModifyCarrierMovement(req.Number, req.Index, req.Movement)
+		return modifyMovementResponse{Err: err}, nil
+	}
+}
+
+type cancelMovementRequest struct {
+	Number voyage.Number
+	Index  int
+}
+
+type cancelMovementResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r cancelMovementResponse) error() error { return r.Err }
+
+func makeCancelMovementEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(cancelMovementRequest)
+		err := s.CancelCarrierMovement(req.Number, req.Index)
+		return cancelMovementResponse{Err: err}, nil
+	}
+}
+
+type delayVoyageRequest struct {
+	Number voyage.Number
+	Index  int
+	Delay  time.Duration
+}
+
+type delayVoyageResponse struct {
+	Err error `json:"error,omitempty"`
+}
+
+func (r delayVoyageResponse) error() error { return r.Err }
+
+func makeDelayVoyageEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(delayVoyageRequest)
+		err := s.DelayVoyage(req.Number, req.Index, req.Delay)
+		return delayVoyageResponse{Err: err}, nil
+	}
+}
+
+type loadVoyageRequest struct {
+	Number voyage.Number
+}
+
+type loadVoyageResponse struct {
+	Voyage *Voyage `json:"voyage,omitempty"`
+	Err    error   `json:"error,omitempty"`
+}
+
+func (r loadVoyageResponse) error() error { return r.Err }
+
+func makeLoadVoyageEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(loadVoyageRequest)
+		v, err := s.LoadVoyage(req.Number)
+		return loadVoyageResponse{Voyage: &v, Err: err}, nil
+	}
+}
+
+type listVoyagesRequest struct{}
+
+type listVoyagesResponse struct {
+	Voyages []Voyage `json:"voyages,omitempty"`
+	Err     error    `json:"error,omitempty"`
+}
+
+func (r listVoyagesResponse) error() error { return r.Err }
+
+func makeListVoyagesEndpoint(s Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		_ = request.(listVoyagesRequest)
+		return listVoyagesResponse{Voyages: s.Voyages(), Err: nil}, nil
+	}
+}
+
+// Set collects all of the endpoints that compose a scheduling service.
+type Set struct {
+	CreateVoyageEndpoint   endpoint.Endpoint
+	AddMovementEndpoint    endpoint.Endpoint
+	ModifyMovementEndpoint endpoint.Endpoint
+	CancelMovementEndpoint endpoint.Endpoint
+	DelayVoyageEndpoint    endpoint.Endpoint
+	LoadVoyageEndpoint     endpoint.Endpoint
+	ListVoyagesEndpoint    endpoint.Endpoint
+}
+
+// NewSet returns a Set that wraps the provided server, and wires in all of the
+// expected endpoint middlewares via the various parameters.
+func NewSet(svc Service, logger log.Logger, duration metrics.Histogram, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer) Set {
+	var createVoyageEndpoint endpoint.Endpoint
+	{
+		createVoyageEndpoint = makeCreateVoyageEndpoint(svc)
+		createVoyageEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(createVoyageEndpoint)
+		createVoyageEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(createVoyageEndpoint)
+		createVoyageEndpoint = opentracing.TraceServer(otTracer, "CreateVoyage")(createVoyageEndpoint)
+		if zipkinTracer != nil {
+			createVoyageEndpoint = zipkin.TraceEndpoint(zipkinTracer, "CreateVoyage")(createVoyageEndpoint)
+		}
+	}
+
+	var addMovementEndpoint endpoint.Endpoint
+	{
+		addMovementEndpoint = makeAddMovementEndpoint(svc)
+		addMovementEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(addMovementEndpoint)
+		addMovementEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(addMovementEndpoint)
+		addMovementEndpoint = opentracing.TraceServer(otTracer, "AddCarrierMovement")(addMovementEndpoint)
+		if zipkinTracer != nil {
+			addMovementEndpoint = zipkin.TraceEndpoint(zipkinTracer, "AddCarrierMovement")(addMovementEndpoint)
+		}
+	}
+
+	var modifyMovementEndpoint endpoint.Endpoint
+	{
+		modifyMovementEndpoint = makeModifyMovementEndpoint(svc)
+		modifyMovementEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(modifyMovementEndpoint)
+		modifyMovementEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(modifyMovementEndpoint)
+		modifyMovementEndpoint = opentracing.TraceServer(otTracer, "ModifyCarrierMovement")(modifyMovementEndpoint)
+		if zipkinTracer != nil {
+			modifyMovementEndpoint = zipkin.TraceEndpoint(zipkinTracer, "ModifyCarrierMovement")(modifyMovementEndpoint)
+		}
+	}
+
+	var cancelMovementEndpoint endpoint.Endpoint
+	{
+		cancelMovementEndpoint = makeCancelMovementEndpoint(svc)
+		cancelMovementEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(cancelMovementEndpoint)
+		cancelMovementEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(cancelMovementEndpoint)
+		cancelMovementEndpoint = opentracing.TraceServer(otTracer, "CancelCarrierMovement")(cancelMovementEndpoint)
+		if zipkinTracer != nil {
+			cancelMovementEndpoint = zipkin.TraceEndpoint(zipkinTracer, "CancelCarrierMovement")(cancelMovementEndpoint)
+		}
+	}
+
+	var delayVoyageEndpoint endpoint.Endpoint
+	{
+		delayVoyageEndpoint = makeDelayVoyageEndpoint(svc)
+		delayVoyageEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(delayVoyageEndpoint)
+		delayVoyageEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(delayVoyageEndpoint)
+		delayVoyageEndpoint = opentracing.TraceServer(otTracer, "DelayVoyage")(delayVoyageEndpoint)
+		if zipkinTracer != nil {
+			delayVoyageEndpoint = zipkin.TraceEndpoint(zipkinTracer, "DelayVoyage")(delayVoyageEndpoint)
+		}
+	}
+
+	var loadVoyageEndpoint endpoint.Endpoint
+	{
+		loadVoyageEndpoint = makeLoadVoyageEndpoint(svc)
+		loadVoyageEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(loadVoyageEndpoint)
+		loadVoyageEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(loadVoyageEndpoint)
+		loadVoyageEndpoint = opentracing.TraceServer(otTracer, "LoadVoyage")(loadVoyageEndpoint)
+		if zipkinTracer != nil {
+			loadVoyageEndpoint = zipkin.TraceEndpoint(zipkinTracer, "LoadVoyage")(loadVoyageEndpoint)
+		}
+	}
+
+	var listVoyagesEndpoint endpoint.Endpoint
+	{
+		listVoyagesEndpoint = makeListVoyagesEndpoint(svc)
+		listVoyagesEndpoint = ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Limit(1), 100))(listVoyagesEndpoint)
+		listVoyagesEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{}))(listVoyagesEndpoint)
+		listVoyagesEndpoint = opentracing.TraceServer(otTracer, "Voyages")(listVoyagesEndpoint)
+		if zipkinTracer != nil {
+			listVoyagesEndpoint = zipkin.TraceEndpoint(zipkinTracer, "Voyages")(listVoyagesEndpoint)
+		}
+	}
+
+	return Set{
+		CreateVoyageEndpoint:   createVoyageEndpoint,
+		AddMovementEndpoint:    addMovementEndpoint,
+		ModifyMovementEndpoint: modifyMovementEndpoint,
+		CancelMovementEndpoint: cancelMovementEndpoint,
+		DelayVoyageEndpoint:    delayVoyageEndpoint,
+		LoadVoyageEndpoint:     loadVoyageEndpoint,
+		ListVoyagesEndpoint:    listVoyagesEndpoint,
+	}
+}
+
+// CreateVoyage implements the service interface so Set can be used as a service
+func (s Set) CreateVoyage(number voyage.Number, movements []voyage.CarrierMovement) error {
+	resp, err := s.CreateVoyageEndpoint(context.Background(), createVoyageRequest{Number: number, Movements: movements})
+	if err != nil {
+		return err
+	}
+	return resp.(createVoyageResponse).Err
+}
+
+// AddCarrierMovement implements the service interface so Set can be used as a service
+func (s Set) AddCarrierMovement(number voyage.Number, m voyage.CarrierMovement) error {
+	resp, err := s.AddMovementEndpoint(context.Background(), addMovementRequest{Number: number, Movement: m})
+	if err != nil {
+		return err
+	}
+	return resp.(addMovementResponse).Err
+}
+
+// ModifyCarrierMovement implements the service interface so Set can be used as a service
+func (s Set) ModifyCarrierMovement(number voyage.Number, index int, m voyage.CarrierMovement) error {
+	resp, err := s.ModifyMovementEndpoint(context.Background(), modifyMovementRequest{Number: number, Index: index, Movement: m})
+	if err != nil {
+		return err
+	}
+	return resp.(modifyMovementResponse).Err
+}
+
+// CancelCarrierMovement implements the service interface so Set can be used as a service
+func (s Set) CancelCarrierMovement(number voyage.Number, index int) error {
+	resp, err := s.CancelMovementEndpoint(context.Background(), cancelMovementRequest{Number: number, Index: index})
+	if err != nil {
+		return err
+	}
+	return resp.(cancelMovementResponse).Err
+}
+
+// DelayVoyage implements the service interface so Set can be used as a service
+func (s Set) DelayVoyage(number voyage.Number, index int, delay time.Duration) error {
+	resp, err := s.DelayVoyageEndpoint(context.Background(), delayVoyageRequest{Number: number, Index: index, Delay: delay})
+	if err != nil {
+		return err
+	}
+	return resp.(delayVoyageResponse).Err
+}
+
+// LoadVoyage implements the service interface so Set can be used as a service
+func (s Set) LoadVoyage(number voyage.Number) (Voyage, error) {
+	resp, err := s.LoadVoyageEndpoint(context.Background(), loadVoyageRequest{Number: number})
+	if err != nil {
+		return Voyage{}, err
+	}
+	response := resp.(loadVoyageResponse)
+	if response.Voyage == nil {
+		return Voyage{}, response.Err
+	}
+	return *response.Voyage, response.Err
+}
+
+// Voyages implements the service interface so Set can be used as a service
+func (s Set) Voyages() []Voyage {
+	resp, err := s.ListVoyagesEndpoint(context.Background(), listVoyagesRequest{})
+	if err != nil {
+		return []Voyage{}
+	}
+	return resp.(listVoyagesResponse).Voyages
+}
@@ -0,0 +1,586 @@
+package scheduling
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/Qalifah/shipping/location"
+	pb "github.com/Qalifah/shipping/pb/schedulingpb"
+	"github.com/Qalifah/shipping/voyage"
+
+	"github.com/go-kit/kit/circuitbreaker"
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/ratelimit"
+	"github.com/go-kit/kit/tracing/opentracing"
+	"github.com/go-kit/kit/tracing/zipkin"
+	"github.com/go-kit/kit/transport"
+	grpctransport "github.com/go-kit/kit/transport/grpc"
+
+	"github.com/golang/protobuf/ptypes"
+	stdopentracing "github.com/opentracing/opentracing-go"
+	stdzipkin "github.com/openzipkin/zipkin-go"
+	"github.com/sony/gobreaker"
+	"golang.org/x/time/rate"
+)
+
+type grpcServer struct {
+	createVoyage   grpctransport.Handler
+	addMovement    grpctransport.Handler
+	modifyMovement grpctransport.Handler
+	cancelMovement grpctransport.Handler
+	delayVoyage    grpctransport.Handler
+	loadVoyage     grpctransport.Handler
+	listVoyages    grpctransport.Handler
+}
+
+// NewGRPCServer makes a set of endpoints available on a grpc server
+func NewGRPCServer(endpoints Set, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer, logger log.Logger) pb.SchedulingServer {
+	options := []grpctransport.ServerOption{
+		grpctransport.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+	}
+
+	if zipkinTracer != nil {
+		options = append(options, zipkin.GRPCServerTrace(zipkinTracer))
+	}
+
+	return &grpcServer{
+		createVoyage: grpctransport.NewServer(
+			endpoints.CreateVoyageEndpoint,
+			decodeGRPCCreateVoyageRequest,
+			encodeGRPCCreateVoyageResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "createVoyage", logger)))...,
+		),
+		addMovement: grpctransport.NewServer(
+			endpoints.AddMovementEndpoint,
+			decodeGRPCAddMovementRequest,
+			encodeGRPCAddMovementResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "addCarrierMovement", logger)))...,
+		),
+		modifyMovement: grpctransport.NewServer(
+			endpoints.ModifyMovementEndpoint,
+			decodeGRPCModifyMovementRequest,
+			encodeGRPCModifyMovementResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "modifyCarrierMovement", logger)))...,
+		),
+		cancelMovement: grpctransport.NewServer(
+			endpoints.CancelMovementEndpoint,
+			decodeGRPCCancelMovementRequest,
+			encodeGRPCCancelMovementResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "cancelCarrierMovement", logger)))...,
+		),
+		delayVoyage: grpctransport.NewServer(
+			endpoints.DelayVoyageEndpoint,
+			decodeGRPCDelayVoyageRequest,
+			encodeGRPCDelayVoyageResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "delayVoyage", logger)))...,
+		),
+		loadVoyage: grpctransport.NewServer(
+			endpoints.LoadVoyageEndpoint,
+			decodeGRPCLoadVoyageRequest,
+			encodeGRPCLoadVoyageResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "loadVoyage", logger)))...,
+		),
+		listVoyages: grpctransport.NewServer(
+			endpoints.ListVoyagesEndpoint,
+			decodeGRPCVoyagesRequest,
+			encodeGRPCVoyagesResponse,
+			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "listVoyages", logger)))...,
+		),
+	}
+}
+
+func (s *grpcServer) CreateVoyage(ctx context.Context, req *pb.CreateVoyageRequest) (*pb.CreateVoyageReply, error) {
+	_, rep, err := s.createVoyage.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.CreateVoyageReply), nil
+}
+
+func (s *grpcServer) AddCarrierMovement(ctx context.Context, req *pb.AddCarrierMovementRequest) (*pb.AddCarrierMovementReply, error) {
+	_, rep, err := s.addMovement.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.AddCarrierMovementReply), nil
+}
+
+func (s *grpcServer) ModifyCarrierMovement(ctx context.Context, req *pb.ModifyCarrierMovementRequest) (*pb.ModifyCarrierMovementReply, error) {
+	_, rep, err := s.modifyMovement.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.ModifyCarrierMovementReply), nil
+}
+
+func (s *grpcServer) CancelCarrierMovement(ctx context.Context, req *pb.CancelCarrierMovementRequest) (*pb.CancelCarrierMovementReply, error) {
+	_, rep, err := s.cancelMovement.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.CancelCarrierMovementReply), nil
+}
+
+func (s *grpcServer) DelayVoyage(ctx context.Context, req *pb.DelayVoyageRequest) (*pb.DelayVoyageReply, error) {
+	_, rep, err := s.delayVoyage.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.DelayVoyageReply), nil
+}
+
+func (s *grpcServer) LoadVoyage(ctx context.Context, req *pb.LoadVoyageRequest) (*pb.LoadVoyageReply, error) {
+	_, rep, err := s.loadVoyage.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.LoadVoyageReply), nil
+}
+
+func (s *grpcServer) Voyages(ctx context.Context, req *pb.VoyagesRequest) (*pb.VoyagesReply, error) {
+	_, rep, err := s.listVoyages.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rep.(*pb.VoyagesReply), nil
+}
+
+// NewGRPCClient returns a scheduling service backed by a grpc server at the other end of the conn
+func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer, logger log.Logger) Service {
+	limiter := ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Every(time.Second), 100))
+	var options []grpctransport.ClientOption
+	if zipkinTracer != nil {
+		options = append(options, zipkin.GRPCClientTrace(zipkinTracer))
+	}
+
+	var createVoyageEndpoint endpoint.Endpoint
+	{
+		createVoyageEndpoint = grpctransport.NewClient(
+			conn,
+			"schedulingpb.Scheduling",
+			"CreateVoyage",
+			encodeGRPCCreateVoyageRequest,
+			decodeGRPCCreateVoyageResponse,
+			pb.CreateVoyageReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		createVoyageEndpoint = opentracing.TraceClient(otTracer, "Create Voyage")(createVoyageEndpoint)
+		createVoyageEndpoint = limiter(createVoyageEndpoint)
+		createVoyageEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Create Voyage",
+			Timeout: 30 * time.Second,
+		}))(createVoyageEndpoint)
+	}
+
+	var addMovementEndpoint endpoint.Endpoint
+	{
+		addMovementEndpoint = grpctransport.NewClient(
+			conn,
+			"schedulingpb.Scheduling",
+			"AddCarrierMovement",
+			encodeGRPCAddMovementRequest,
+			decodeGRPCAddMovementResponse,
+			pb.AddCarrierMovementReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		addMovementEndpoint = opentracing.TraceClient(otTracer, "Add Carrier Movement")(addMovementEndpoint)
+		addMovementEndpoint = limiter(addMovementEndpoint)
+		addMovementEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Add Carrier Movement",
+			Timeout: 30 * time.Second,
+		}))(addMovementEndpoint)
+	}
+
+	var modifyMovementEndpoint endpoint.Endpoint
+	{
+		modifyMovementEndpoint = grpctransport.NewClient(
+			conn,
+			"schedulingpb.Scheduling",
+			"ModifyCarrierMovement",
+			encodeGRPCModifyMovementRequest,
+			decodeGRPCModifyMovementResponse,
+			pb.ModifyCarrierMovementReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		modifyMovementEndpoint = opentracing.TraceClient(otTracer, "Modify Carrier Movement")(modifyMovementEndpoint)
+		modifyMovementEndpoint = limiter(modifyMovementEndpoint)
+		modifyMovementEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Modify Carrier Movement",
+			Timeout: 30 * time.Second,
+		}))(modifyMovementEndpoint)
+	}
+
+	var cancelMovementEndpoint endpoint.Endpoint
+	{
+		cancelMovementEndpoint = grpctransport.NewClient(
+			conn,
+			"schedulingpb.Scheduling",
+			"CancelCarrierMovement",
+			encodeGRPCCancelMovementRequest,
+			decodeGRPCCancelMovementResponse,
+			pb.CancelCarrierMovementReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		cancelMovementEndpoint = opentracing.TraceClient(otTracer, "Cancel Carrier Movement")(cancelMovementEndpoint)
+		cancelMovementEndpoint = limiter(cancelMovementEndpoint)
+		cancelMovementEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Cancel Carrier Movement",
+			Timeout: 30 * time.Second,
+		}))(cancelMovementEndpoint)
+	}
+
+	var delayVoyageEndpoint endpoint.Endpoint
+	{
+		delayVoyageEndpoint = grpctransport.NewClient(
+			conn,
+			"schedulingpb.Scheduling",
+			"DelayVoyage",
+			encodeGRPCDelayVoyageRequest,
+			decodeGRPCDelayVoyageResponse,
+			pb.DelayVoyageReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		delayVoyageEndpoint = opentracing.TraceClient(otTracer, "Delay Voyage")(delayVoyageEndpoint)
+		delayVoyageEndpoint = limiter(delayVoyageEndpoint)
+		delayVoyageEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Delay Voyage",
+			Timeout: 30 * time.Second,
+		}))(delayVoyageEndpoint)
+	}
+
+	var loadVoyageEndpoint endpoint.Endpoint
+	{
+		loadVoyageEndpoint = grpctransport.NewClient(
+			conn,
+			"schedulingpb.Scheduling",
+			"LoadVoyage",
+			encodeGRPCLoadVoyageRequest,
+			decodeGRPCLoadVoyageResponse,
+			pb.LoadVoyageReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		loadVoyageEndpoint = opentracing.TraceClient(otTracer, "Load Voyage")(loadVoyageEndpoint)
+		loadVoyageEndpoint = limiter(loadVoyageEndpoint)
+		loadVoyageEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Load Voyage",
+			Timeout: 30 * time.Second,
+		}))(loadVoyageEndpoint)
+	}
+
+	var listVoyagesEndpoint endpoint.Endpoint
+	{
+		listVoyagesEndpoint = grpctransport.NewClient(
+			conn,
+			"schedulingpb.Scheduling",
+			"Voyages",
+			encodeGRPCVoyagesRequest,
+			decodeGRPCVoyagesResponse,
+			pb.VoyagesReply{},
+			append(options, grpctransport.ClientBefore(opentracing.ContextToGRPC(otTracer, logger)))...,
+		).Endpoint()
+		listVoyagesEndpoint = opentracing.TraceClient(otTracer, "Voyages")(listVoyagesEndpoint)
+		listVoyagesEndpoint = limiter(listVoyagesEndpoint)
+		listVoyagesEndpoint = circuitbreaker.Gobreaker(gobreaker.NewCircuitBreaker(gobreaker.Settings{
+			Name:    "Voyages",
+			Timeout: 30 * time.Second,
+		}))(listVoyagesEndpoint)
+	}
+
+	return Set{
+		CreateVoyageEndpoint:   createVoyageEndpoint,
+		AddMovementEndpoint:    addMovementEndpoint,
+		ModifyMovementEndpoint: modifyMovementEndpoint,
+		CancelMovementEndpoint: cancelMovementEndpoint,
+		DelayVoyageEndpoint:    delayVoyageEndpoint,
+		LoadVoyageEndpoint:     loadVoyageEndpoint,
+		ListVoyagesEndpoint:    listVoyagesEndpoint,
+	}
+}
+
+func decodeGRPCCreateVoyageRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CreateVoyageRequest)
+	return createVoyageRequest{
+		Number:    voyage.Number(req.VoyageNumber),
+		Movements: decodeMovements(req.Movements),
+	}, nil
+}
+
+func decodeGRPCAddMovementRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.AddCarrierMovementRequest)
+	return addMovementRequest{
+		Number:   voyage.Number(req.VoyageNumber),
+		Movement: decodeMovement(req.Movement),
+	}, nil
+}
+
+func decodeGRPCModifyMovementRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.ModifyCarrierMovementRequest)
+	return modifyMovementRequest{
+		Number:   voyage.Number(req.VoyageNumber),
+		Index:    int(req.Index),
+		Movement: decodeMovement(req.Movement),
+	}, nil
+}
+
+func decodeGRPCCancelMovementRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.CancelCarrierMovementRequest)
+	return cancelMovementRequest{
+		Number: voyage.Number(req.VoyageNumber),
+		Index:  int(req.Index),
+	}, nil
+}
+
+func decodeGRPCDelayVoyageRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.DelayVoyageRequest)
+	return delayVoyageRequest{
+		Number: voyage.Number(req.VoyageNumber),
+		Index:  int(req.Movement),
+		Delay:  time.Duration(req.DelaySeconds) * time.Second,
+	}, nil
+}
+
+func decodeGRPCLoadVoyageRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*pb.LoadVoyageRequest)
+	return loadVoyageRequest{Number: voyage.Number(req.VoyageNumber)}, nil
+}
+
+func decodeGRPCVoyagesRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	_ = grpcReq.(*pb.VoyagesRequest)
+	return listVoyagesRequest{}, nil
+}
+
+func encodeGRPCCreateVoyageResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(createVoyageResponse)
+	return &pb.CreateVoyageReply{Err: err2str(resp.Err)}, nil
+}
+
+func encodeGRPCAddMovementResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(addMovementResponse)
+	return &pb.AddCarrierMovementReply{Err: err2str(resp.Err)}, nil
+}
+
+func encodeGRPCModifyMovementResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(modifyMovementResponse)
+	return &pb.ModifyCarrierMovementReply{Err: err2str(resp.Err)}, nil
+}
+
+func encodeGRPCCancelMovementResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(cancelMovementResponse)
+	return &pb.CancelCarrierMovementReply{Err: err2str(resp.Err)}, nil
+}
+
+func encodeGRPCDelayVoyageResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(delayVoyageResponse)
+	return &pb.DelayVoyageReply{Err: err2str(resp.Err)}, nil
+}
+
+func encodeGRPCLoadVoyageResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(loadVoyageResponse)
+	reply := &pb.LoadVoyageReply{Err: err2str(resp.Err)}
+	if resp.Err == nil && resp.Voyage != nil {
+		reply.Voyage = encodeVoyage(*resp.Voyage)
+	}
+	return reply, nil
+}
+
+func encodeGRPCVoyagesResponse(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(listVoyagesResponse)
+	var voyages []*pb.Voyage
+	for _, v := range resp.Voyages {
+		voyages = append(voyages, encodeVoyage(v))
+	}
+	return &pb.VoyagesReply{Voyages: voyages}, nil
+}
+
+func encodeGRPCCreateVoyageRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(createVoyageRequest)
+	return &pb.CreateVoyageRequest{
+		VoyageNumber: string(req.Number),
+		Movements:    encodeMovements(req.Movements),
+	}, nil
+}
+
+func encodeGRPCAddMovementRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(addMovementRequest)
+	return &pb.AddCarrierMovementRequest{
+		VoyageNumber: string(req.Number),
+		Movement:     encodeMovement(req.Movement),
+	}, nil
+}
+
+func encodeGRPCModifyMovementRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(modifyMovementRequest)
+	return &pb.ModifyCarrierMovementRequest{
+		VoyageNumber: string(req.Number),
+		Index:        int32(req.Index),
+		Movement:     encodeMovement(req.Movement),
+	}, nil
+}
+
+func encodeGRPCCancelMovementRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(cancelMovementRequest)
+	return &pb.CancelCarrierMovementRequest{
+		VoyageNumber: string(req.Number),
+		Index:        int32(req.Index),
+	}, nil
+}
+
+func encodeGRPCDelayVoyageRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(delayVoyageRequest)
+	return &pb.DelayVoyageRequest{
+		VoyageNumber: string(req.Number),
+		Movement:     int32(req.Index),
+		DelaySeconds: int64(req.Delay / time.Second),
+	}, nil
+}
+
+func encodeGRPCLoadVoyageRequest(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(loadVoyageRequest)
+	return &pb.LoadVoyageRequest{VoyageNumber: string(req.Number)}, nil
+}
+
+func encodeGRPCVoyagesRequest(_ context.Context, request interface{}) (interface{}, error) {
+	_ = request.(listVoyagesRequest)
+	return &pb.VoyagesRequest{}, nil
+}
+
+func decodeGRPCCreateVoyageResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.CreateVoyageReply)
+	return createVoyageResponse{Err: str2err(reply.Err)}, nil
+}
+
+func decodeGRPCAddMovementResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.AddCarrierMovementReply)
+	return addMovementResponse{Err: str2err(reply.Err)}, nil
+}
+
+func decodeGRPCModifyMovementResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.ModifyCarrierMovementReply)
+	return modifyMovementResponse{Err: str2err(reply.Err)}, nil
+}
+
+func decodeGRPCCancelMovementResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.CancelCarrierMovementReply)
+	return cancelMovementResponse{Err: str2err(reply.Err)}, nil
+}
+
+func decodeGRPCDelayVoyageResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.DelayVoyageReply)
+	return delayVoyageResponse{Err: str2err(reply.Err)}, nil
+}
+
+func decodeGRPCLoadVoyageResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.LoadVoyageReply)
+	if reply.Voyage == nil {
+		return loadVoyageResponse{Err: str2err(reply.Err)}, nil
+	}
+	v := decodeVoyage(reply.Voyage)
+	return loadVoyageResponse{Voyage: &v, Err: str2err(reply.Err)}, nil
+}
+
+func decodeGRPCVoyagesResponse(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*pb.VoyagesReply)
+	voyages := make([]Voyage, 0, len(reply.Voyages))
+	for _, v := range reply.Voyages {
+		voyages = append(voyages, decodeVoyage(v))
+	}
+	return listVoyagesResponse{Voyages: voyages}, nil
+}
+
+func encodeVoyage(v Voyage) *pb.Voyage {
+	movements := make([]*pb.CarrierMovement, 0, len(v.Movements))
+	for _, m := range v.Movements {
+		movements = append(movements, encodeMovement(toCarrierMovement(m)))
+	}
+	return &pb.Voyage{
+		VoyageNumber: v.VoyageNumber,
+		Movements:    movements,
+	}
+}
+
+func decodeVoyage(v *pb.Voyage) Voyage {
+	movements := make([]CarrierMovement, 0, len(v.Movements))
+	for _, m := range decodeMovements(v.Movements) {
+		movements = append(movements, CarrierMovement{
+			DepartureLocation: string(m.DepartureLocation),
+			ArrivalLocation:   string(m.ArrivalLocation),
+			DepartureTime:     m.DepartureTime,
+			ArrivalTime:       m.ArrivalTime,
+		})
+	}
+	return Voyage{
+		VoyageNumber: v.VoyageNumber,
+		Movements:    movements,
+	}
+}
+
+func encodeMovements(movements []voyage.CarrierMovement) []*pb.CarrierMovement {
+	var result []*pb.CarrierMovement
+	for _, m := range movements {
+		result = append(result, encodeMovement(m))
+	}
+	return result
+}
+
+func decodeMovements(movements []*pb.CarrierMovement) []voyage.CarrierMovement {
+	var result []voyage.CarrierMovement
+	for _, m := range movements {
+		result = append(result, decodeMovement(m))
+	}
+	return result
+}
+
+func encodeMovement(m voyage.CarrierMovement) *pb.CarrierMovement {
+	departure, _ := ptypes.TimestampProto(m.DepartureTime)
+	arrival, _ := ptypes.TimestampProto(m.ArrivalTime)
+	return &pb.CarrierMovement{
+		DepartureLocation: string(m.DepartureLocation),
+		ArrivalLocation:   string(m.ArrivalLocation),
+		DepartureTime:     departure,
+		ArrivalTime:       arrival,
+	}
+}
+
+func decodeMovement(m *pb.CarrierMovement) voyage.CarrierMovement {
+	if m == nil {
+		return voyage.CarrierMovement{}
+	}
+	var departure, arrival time.Time
+	if m.DepartureTime != nil {
+		departure, _ = ptypes.Timestamp(m.DepartureTime)
+	}
+	if m.ArrivalTime != nil {
+		arrival, _ = ptypes.Timestamp(m.ArrivalTime)
+	}
+	return voyage.CarrierMovement{
+		DepartureLocation: location.UNLcode(m.DepartureLocation),
+		ArrivalLocation:   location.UNLcode(m.ArrivalLocation),
+		DepartureTime:     departure,
+		ArrivalTime:       arrival,
+	}
+}
+
+func err2str(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func str2err(s string) error {
+	if s == "" {
+		return nil
+	}
+	return errors.New(s)
+}
@@ -0,0 +1,243 @@
+package scheduling
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	kitlog "github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/transport"
+	kithttp "github.com/go-kit/kit/transport/http"
+
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+// MakeHandler returns a handler for the scheduling service.
+func MakeHandler(s Service, logger kitlog.Logger) http.Handler {
+	opts := []kithttp.ServerOption{
+		kithttp.ServerErrorHandler(transport.NewLogErrorHandler(logger)),
+		kithttp.ServerErrorEncoder(encodeError),
+	}
+
+	createVoyageHandler := kithttp.NewServer(
+		makeCreateVoyageEndpoint(s),
+		decodeCreateVoyageRequest,
+		encodeResponse,
+		opts...,
+	)
+	listVoyagesHandler := kithttp.NewServer(
+		makeListVoyagesEndpoint(s),
+		decodeListVoyagesRequest,
+		encodeResponse,
+		opts...,
+	)
+	loadVoyageHandler := kithttp.NewServer(
+		makeLoadVoyageEndpoint(s),
+		decodeLoadVoyageRequest,
+		encodeResponse,
+		opts...,
+	)
+	addMovementHandler := kithttp.NewServer(
+		makeAddMovementEndpoint(s),
+		decodeAddMovementRequest,
+		encodeResponse,
+		opts...,
+	)
+	modifyMovementHandler := kithttp.NewServer(
+		makeModifyMovementEndpoint(s),
+		decodeModifyMovementRequest,
+		encodeResponse,
+		opts...,
+	)
+	cancelMovementHandler := kithttp.NewServer(
+		makeCancelMovementEndpoint(s),
+		decodeCancelMovementRequest,
+		encodeResponse,
+		opts...,
+	)
+	delayVoyageHandler := kithttp.NewServer(
+		makeDelayVoyageEndpoint(s),
+		decodeDelayVoyageRequest,
+		encodeResponse,
+		opts...,
+	)
+
+	r := mux.NewRouter()
+
+	r.Handle("/scheduling/v1/voyages", createVoyageHandler).Methods("POST")
+	r.Handle("/scheduling/v1/voyages", listVoyagesHandler).Methods("GET")
+	r.Handle("/scheduling/v1/voyages/{number}", loadVoyageHandler).Methods("GET")
+	r.Handle("/scheduling/v1/voyages/{number}/movements", addMovementHandler).Methods("POST")
+	r.Handle("/scheduling/v1/voyages/{number}/movements/{index}", modifyMovementHandler).Methods("PUT")
+	r.Handle("/scheduling/v1/voyages/{number}/movements/{index}", cancelMovementHandler).Methods("DELETE")
+	r.Handle("/scheduling/v1/voyages/{number}/delay", delayVoyageHandler).Methods("POST")
+
+	return r
+}
+
+var errBadRoute = errors.New("bad route")
+
+func decodeCreateVoyageRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var body struct {
+		VoyageNumber string            `json:"voyage_number"`
+		Movements    []CarrierMovement `json:"movements"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	movements := make([]voyage.CarrierMovement, 0, len(body.Movements))
+	for _, m := range body.Movements {
+		movements = append(movements, toCarrierMovement(m))
+	}
+
+	return createVoyageRequest{
+		Number:    voyage.Number(body.VoyageNumber),
+		Movements: movements,
+	}, nil
+}
+
+func decodeListVoyagesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return listVoyagesRequest{}, nil
+}
+
+func decodeLoadVoyageRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	number, ok := vars["number"]
+	if !ok {
+		return nil, errBadRoute
+	}
+	return loadVoyageRequest{Number: voyage.Number(number)}, nil
+}
+
+func decodeAddMovementRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	number, ok := vars["number"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body CarrierMovement
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return addMovementRequest{
+		Number:   voyage.Number(number),
+		Movement: toCarrierMovement(body),
+	}, nil
+}
+
+func decodeModifyMovementRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	number, index, err := movementVars(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var body CarrierMovement
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return modifyMovementRequest{
+		Number:   number,
+		Index:    index,
+		Movement: toCarrierMovement(body),
+	}, nil
+}
+
+func decodeCancelMovementRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	number, index, err := movementVars(r)
+	if err != nil {
+		return nil, err
+	}
+	return cancelMovementRequest{Number: number, Index: index}, nil
+}
+
+func decodeDelayVoyageRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	number, ok := vars["number"]
+	if !ok {
+		return nil, errBadRoute
+	}
+
+	var body struct {
+		Movement int    `json:"movement"`
+		Delay    string `json:"delay"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	delay, err := time.ParseDuration(body.Delay)
+	if err != nil {
+		return nil, ErrInvalidArgument
+	}
+
+	return delayVoyageRequest{
+		Number: voyage.Number(number),
+		Index:  body.Movement,
+		Delay:  delay,
+	}, nil
+}
+
+func movementVars(r *http.Request) (voyage.Number, int, error) {
+	vars := mux.Vars(r)
+	number, ok := vars["number"]
+	if !ok {
+		return "", 0, errBadRoute
+	}
+	index, err := strconv.Atoi(vars["index"])
+	if err != nil {
+		return "", 0, ErrUnknownMovement
+	}
+	return voyage.Number(number), index, nil
+}
+
+func toCarrierMovement(m CarrierMovement) voyage.CarrierMovement {
+	return voyage.CarrierMovement{
+		DepartureLocation: location.UNLcode(m.DepartureLocation),
+		ArrivalLocation:   location.UNLcode(m.ArrivalLocation),
+		DepartureTime:     m.DepartureTime,
+		ArrivalTime:       m.ArrivalTime,
+	}
+}
+
+func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	if e, ok := response.(errorer); ok && e.error() != nil {
+		encodeError(ctx, e.error(), w)
+		return nil
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	return json.NewEncoder(w).Encode(response)
+}
+
+type errorer interface {
+	error() error
+}
+
+// encode errors from business-logic
+func encodeError(_ context.Context, err error, w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	switch err {
+	case voyage.ErrUnknown, ErrUnknownMovement:
+		w.WriteHeader(http.StatusNotFound)
+	case ErrInvalidArgument, location.ErrUnknown:
+		w.WriteHeader(http.StatusBadRequest)
+	case ErrVoyageExists, ErrOverlappingMovements:
+		w.WriteHeader(http.StatusConflict)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
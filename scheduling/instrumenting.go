@@ -0,0 +1,87 @@
+package scheduling
+
+import (
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+
+	"github.com/Qalifah/shipping/voyage"
+)
+
+type instrumentingService struct {
+	requestCount   metrics.Counter
+	requestLatency metrics.Histogram
+	Service
+}
+
+// NewInstrumentingService returns an instance of an instrumenting Service.
+func NewInstrumentingService(counter metrics.Counter, latency metrics.Histogram, s Service) Service {
+	return &instrumentingService{
+		requestCount:   counter,
+		requestLatency: latency,
+		Service:        s,
+	}
+}
+
+func (s *instrumentingService) CreateVoyage(number voyage.Number, movements []voyage.CarrierMovement) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "create_voyage").Add(1)
+		s.requestLatency.With("method", "create_voyage").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.CreateVoyage(number, movements)
+}
+
+func (s *instrumentingService) AddCarrierMovement(number voyage.Number, m voyage.CarrierMovement) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "add_carrier_movement").Add(1)
+		s.requestLatency.With("method", "add_carrier_movement").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.AddCarrierMovement(number, m)
+}
+
+func (s *instrumentingService) ModifyCarrierMovement(number voyage.Number, index int, m voyage.CarrierMovement) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "modify_carrier_movement").Add(1)
+		s.requestLatency.With("method", "modify_carrier_movement").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.ModifyCarrierMovement(number, index, m)
+}
+
+func (s *instrumentingService) CancelCarrierMovement(number voyage.Number, index int) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "cancel_carrier_movement").Add(1)
+		s.requestLatency.With("method", "cancel_carrier_movement").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.CancelCarrierMovement(number, index)
+}
+
+func (s *instrumentingService) DelayVoyage(number voyage.Number, index int, delay time.Duration) error {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "delay_voyage").Add(1)
+		s.requestLatency.With("method", "delay_voyage").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.DelayVoyage(number, index, delay)
+}
+
+func (s *instrumentingService) LoadVoyage(number voyage.Number) (Voyage, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "load_voyage").Add(1)
+		s.requestLatency.With("method", "load_voyage").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.LoadVoyage(number)
+}
+
+func (s *instrumentingService) Voyages() []Voyage {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "list_voyages").Add(1)
+		s.requestLatency.With("method", "list_voyages").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Voyages()
+}
@@ -0,0 +1,114 @@
+package scheduling
+
+import (
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/Qalifah/shipping/voyage"
+)
+
+type loggingService struct {
+	logger log.Logger
+	Service
+}
+
+// NewLoggingService returns a new instance of a logging Service.
+func NewLoggingService(logger log.Logger, s Service) Service {
+	return &loggingService{logger, s}
+}
+
+func (s *loggingService) CreateVoyage(number voyage.Number, movements []voyage.CarrierMovement) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "create_voyage",
+			"voyage", number,
+			"movements", len(movements),
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.CreateVoyage(number, movements)
+}
+
+func (s *loggingService) AddCarrierMovement(number voyage.Number, m voyage.CarrierMovement) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "add_carrier_movement",
+			"voyage", number,
+			"from", m.DepartureLocation,
+			"to", m.ArrivalLocation,
+			"departure", m.DepartureTime,
+			"arrival", m.ArrivalTime,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.AddCarrierMovement(number, m)
+}
+
+func (s *loggingService) ModifyCarrierMovement(number voyage.Number, index int, m voyage.CarrierMovement) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "modify_carrier_movement",
+			"voyage", number,
+			"index", index,
+			"from", m.DepartureLocation,
+			"to", m.ArrivalLocation,
+			"departure", m.DepartureTime,
+			"arrival", m.ArrivalTime,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.ModifyCarrierMovement(number, index, m)
+}
+
+func (s *loggingService) CancelCarrierMovement(number voyage.Number, index int) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "cancel_carrier_movement",
+			"voyage", number,
+			"index", index,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.CancelCarrierMovement(number, index)
+}
+
+func (s *loggingService) DelayVoyage(number voyage.Number, index int, delay time.Duration) (err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "delay_voyage",
+			"voyage", number,
+			"index", index,
+			"delay", delay,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.DelayVoyage(number, index, delay)
+}
+
+func (s *loggingService) LoadVoyage(number voyage.Number) (v Voyage, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "load_voyage",
+			"voyage", number,
+			"took", time.Since(begin),
+			"err", err,
+		)
+	}(time.Now())
+	return s.Service.LoadVoyage(number)
+}
+
+func (s *loggingService) Voyages() []Voyage {
+	defer func(begin time.Time) {
+		s.logger.Log(
+			"method", "list_voyages",
+			"took", time.Since(begin),
+		)
+	}(time.Now())
+	return s.Service.Voyages()
+}
@@ -0,0 +1,272 @@
+// Package scheduling provides the use-cases for managing voyage schedules.
+package scheduling
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Qalifah/shipping/inspection"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+// ErrInvalidArgument is returned when one or more arguments are invalid
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrVoyageExists is returned when creating a voyage with a number already in
+// use
+var ErrVoyageExists = errors.New("voyage already exists")
+
+// ErrUnknownMovement is returned when a carrier movement index is out of
+// range of the schedule
+var ErrUnknownMovement = errors.New("unknown carrier movement")
+
+// ErrOverlappingMovements is returned when a carrier movement would depart
+// before the previous one has arrived
+var ErrOverlappingMovements = errors.New("carrier movements overlap")
+
+// EventHandler provides a means of subscribing to schedule changes
+type EventHandler interface {
+	VoyageWasDelayed(number voyage.Number, from time.Time, delay time.Duration)
+}
+
+// Service is the interface that provides scheduling methods. Carrier
+// movements are kept in order of departure and are identified by their
+// index in that order, counting from zero.
+type Service interface {
+	// CreateVoyage registers a new voyage with the given carrier movements,
+	// which may be empty.
+	CreateVoyage(number voyage.Number, movements []voyage.CarrierMovement) error
+
+	// AddCarrierMovement adds a carrier movement to the schedule of a voyage.
+	AddCarrierMovement(number voyage.Number, m voyage.CarrierMovement) error
+
+	// ModifyCarrierMovement replaces the carrier movement at the index.
+	ModifyCarrierMovement(number voyage.Number, index int, m voyage.CarrierMovement) error
+
+	// CancelCarrierMovement removes the carrier movement at the index.
+	CancelCarrierMovement(number voyage.Number, index int) error
+
+	// DelayVoyage shifts the carrier movement at the index, and all
+	// following ones, by the delay, and notifies interested parties so that
+	// the cargos on the voyage can be rescheduled.
+	DelayVoyage(number voyage.Number, index int, delay time.Duration) error
+
+	// LoadVoyage returns a read model of a voyage.
+	LoadVoyage(number voyage.Number) (Voyage, error)
+
+	// Voyages returns all registered voyages.
+	Voyages() []Voyage
+}
+
+type service struct {
+	mtx       sync.Mutex
+	voyages   voyage.Repository
+	locations location.Repository
+	handler   EventHandler
+}
+
+func (s *service) CreateVoyage(number voyage.Number, movements []voyage.CarrierMovement) error {
+	if number == "" {
+		return ErrInvalidArgument
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if _, err := s.voyages.Find(number); err == nil {
+		return ErrVoyageExists
+	}
+
+	schedule := make([]voyage.CarrierMovement, len(movements))
+	copy(schedule, movements)
+	return s.store(number, schedule)
+}
+
+func (s *service) AddCarrierMovement(number voyage.Number, m voyage.CarrierMovement) error {
+	return s.update(number, func(schedule []voyage.CarrierMovement) ([]voyage.CarrierMovement, error) {
+		return append(schedule, m), nil
+	})
+}
+
+func (s *service) ModifyCarrierMovement(number voyage.Number, index int, m voyage.CarrierMovement) error {
+	return s.update(number, func(schedule []voyage.CarrierMovement) ([]voyage.CarrierMovement, error) {
+		if index < 0 || index >= len(schedule) {
+			return nil, ErrUnknownMovement
+		}
+		schedule[index] = m
+		return schedule, nil
+	})
+}
+
+func (s *service) CancelCarrierMovement(number voyage.Number, index int) error {
+	return s.update(number, func(schedule []voyage.CarrierMovement) ([]voyage.CarrierMovement, error) {
+		if index < 0 || index >= len(schedule) {
+			return nil, ErrUnknownMovement
+		}
+		return append(schedule[:index], schedule[index+1:]...), nil
+	})
+}
+
+func (s *service) DelayVoyage(number voyage.Number, index int, delay time.Duration) error {
+	if delay <= 0 {
+		return ErrInvalidArgument
+	}
+
+	var from time.Time
+	err := s.update(number, func(schedule []voyage.CarrierMovement) ([]voyage.CarrierMovement, error) {
+		if index < 0 || index >= len(schedule) {
+			return nil, ErrUnknownMovement
+		}
+		from = schedule[index].DepartureTime
+		for i := index; i < len(schedule); i++ {
+			schedule[i].DepartureTime = schedule[i].DepartureTime.Add(delay)
+			schedule[i].ArrivalTime = schedule[i].ArrivalTime.Add(delay)
+		}
+		return schedule, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.handler.VoyageWasDelayed(number, from, delay)
+
+	return nil
+}
+
+func (s *service) LoadVoyage(number voyage.Number) (Voyage, error) {
+	if number == "" {
+		return Voyage{}, ErrInvalidArgument
+	}
+	v, err := s.voyages.Find(number)
+	if err != nil {
+		return Voyage{}, err
+	}
+	return assemble(v), nil
+}
+
+func (s *service) Voyages() []Voyage {
+	result := make([]Voyage, 0)
+	for _, v := range s.voyages.FindAll() {
+		result = append(result, assemble(v))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].VoyageNumber < result[j].VoyageNumber })
+	return result
+}
+
+// update applies f to a copy of the schedule of a voyage, and stores the
+// result if it is still a valid schedule.
+func (s *service) update(number voyage.Number, f func([]voyage.CarrierMovement) ([]voyage.CarrierMovement, error)) error {
+	if number == "" {
+		return ErrInvalidArgument
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	v, err := s.voyages.Find(number)
+	if err != nil {
+		return err
+	}
+
+	schedule := make([]voyage.CarrierMovement, len(v.Schedule.CarrierMovements))
+	copy(schedule, v.Schedule.CarrierMovements)
+
+	schedule, err = f(schedule)
+	if err != nil {
+		return err
+	}
+	return s.store(number, schedule)
+}
+
+// store orders the movements by departure and stores them as the schedule of
+// the voyage, unless a movement is invalid or the movements overlap.
+func (s *service) store(number voyage.Number, schedule []voyage.CarrierMovement) error {
+	for _, m := range schedule {
+		if err := s.validate(m); err != nil {
+			return err
+		}
+	}
+
+	sort.SliceStable(schedule, func(i, j int) bool {
+		return schedule[i].DepartureTime.Before(schedule[j].DepartureTime)
+	})
+	for i := 1; i < len(schedule); i++ {
+		if schedule[i].DepartureTime.Before(schedule[i-1].ArrivalTime) {
+			return ErrOverlappingMovements
+		}
+	}
+
+	return s.voyages.Store(voyage.New(number, voyage.Schedule{CarrierMovements: schedule}))
+}
+
+func (s *service) validate(m voyage.CarrierMovement) error {
+	if m.DepartureLocation == "" || m.ArrivalLocation == "" || m.DepartureLocation == m.ArrivalLocation {
+		return ErrInvalidArgument
+	}
+	if m.DepartureTime.IsZero() || !m.ArrivalTime.After(m.DepartureTime) {
+		return ErrInvalidArgument
+	}
+	for _, l := range []location.UNLcode{m.DepartureLocation, m.ArrivalLocation} {
+		if _, err := s.locations.Find(l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewService creates a scheduling service with necessary dependencies.
+func NewService(voyages voyage.Repository, locations location.Repository, handler EventHandler) Service {
+	return &service{
+		voyages:   voyages,
+		locations: locations,
+		handler:   handler,
+	}
+}
+
+type schedulingEventHandler struct {
+	InspectionService inspection.Service
+}
+
+func (h *schedulingEventHandler) VoyageWasDelayed(number voyage.Number, from time.Time, delay time.Duration) {
+	h.InspectionService.InspectDelayedVoyage(number, from, delay)
+}
+
+// NewEventHandler returns a new instance of a EventHandler.
+func NewEventHandler(s inspection.Service) EventHandler {
+	return &schedulingEventHandler{
+		InspectionService: s,
+	}
+}
+
+// Voyage is a read model for scheduling views.
+type Voyage struct {
+	VoyageNumber string            `json:"voyage_number"`
+	Movements    []CarrierMovement `json:"movements"`
+}
+
+// CarrierMovement is a read model for a carrier movement.
+type CarrierMovement struct {
+	DepartureLocation string    `json:"departure_location"`
+	ArrivalLocation   string    `json:"arrival_location"`
+	DepartureTime     time.Time `json:"departure_time"`
+	ArrivalTime       time.Time `json:"arrival_time"`
+}
+
+func assemble(v *voyage.Voyage) Voyage {
+	movements := make([]CarrierMovement, 0, len(v.Schedule.CarrierMovements))
+	for _, m := range v.Schedule.CarrierMovements {
+		movements = append(movements, CarrierMovement{
+			DepartureLocation: string(m.DepartureLocation),
+			ArrivalLocation:   string(m.ArrivalLocation),
+			DepartureTime:     m.DepartureTime,
+			ArrivalTime:       m.ArrivalTime,
+		})
+	}
+	return Voyage{
+		VoyageNumber: string(v.Number),
+		Movements:    movements,
+	}
+}
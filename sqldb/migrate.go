@@ -0,0 +1,111 @@
+package sqldb
+
+import (
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+type migration struct {
+	version int
+	name    string
+	query   string
+}
+
+// Migrate brings the schema of db up to date by applying, in order, every
+// embedded migration that has not been applied yet. Each migration runs in
+// its own transaction and is recorded in the schema_migrations table.
+func Migrate(db *sql.DB) error {
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+		version     INTEGER PRIMARY KEY,
+		name        TEXT NOT NULL
+	)`); err != nil {
+		return err
+	}
+
+	ms, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	applied := make(map[int]bool)
+	rows, err := db.Query(`SELECT version FROM schema_migrations`)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			rows.Close()
+			return err
+		}
+		applied[v] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, m := range ms {
+		if applied[m.version] {
+			continue
+		}
+		if err := apply(db, m); err != nil {
+			return fmt.Errorf("migration %s: %v", m.name, err)
+		}
+	}
+	return nil
+}
+
+func apply(db *sql.DB, m migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(m.query); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`INSERT INTO schema_migrations (version, name) VALUES (?, ?)`, m.version, m.name); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// loadMigrations reads the embedded migrations, named <version>_<name>.sql,
+// sorted by version.
+func loadMigrations() ([]migration, error) {
+	files, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	var ms []migration
+	for _, f := range files {
+		name := strings.TrimSuffix(strings.TrimPrefix(f, "migrations/"), ".sql")
+		i := strings.Index(name, "_")
+		if i < 0 {
+			return nil, fmt.Errorf("migration %s: missing version prefix", f)
+		}
+		v, err := strconv.Atoi(name[:i])
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %v", f, err)
+		}
+		b, err := migrations.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		ms = append(ms, migration{version: v, name: name, query: string(b)})
+	}
+
+	sort.Slice(ms, func(i, j int) bool { return ms[i].version < ms[j].version })
+	return ms, nil
+}
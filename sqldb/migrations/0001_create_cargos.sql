@@ -0,0 +1,35 @@
+CREATE TABLE cargos (
+	tracking_id                 TEXT PRIMARY KEY,
+	origin                      TEXT NOT NULL,
+	routing_status              INTEGER NOT NULL,
+	transport_status            INTEGER NOT NULL,
+	last_event_type             INTEGER NOT NULL,
+	last_event_location         TEXT NOT NULL,
+	last_event_voyage           TEXT NOT NULL,
+	last_known_location         TEXT NOT NULL,
+	current_voyage              TEXT NOT NULL,
+	next_activity_type          INTEGER NOT NULL,
+	next_activity_location      TEXT NOT NULL,
+	next_activity_voyage        TEXT NOT NULL,
+	eta                         TIMESTAMP NOT NULL,
+	is_misdirected              BOOLEAN NOT NULL,
+	is_unloaded_at_destination  BOOLEAN NOT NULL
+);
+
+CREATE TABLE route_specifications (
+	tracking_id  TEXT PRIMARY KEY REFERENCES cargos (tracking_id),
+	origin       TEXT NOT NULL,
+	destination  TEXT NOT NULL,
+	deadline     TIMESTAMP NOT NULL
+);
+
+CREATE TABLE itinerary_legs (
+	tracking_id      TEXT NOT NULL REFERENCES cargos (tracking_id),
+	position         INTEGER NOT NULL,
+	voyage_number    TEXT NOT NULL,
+	load_location    TEXT NOT NULL,
+	unload_location  TEXT NOT NULL,
+	load_time        TIMESTAMP NOT NULL,
+	unload_time      TIMESTAMP NOT NULL,
+	PRIMARY KEY (tracking_id, position)
+);
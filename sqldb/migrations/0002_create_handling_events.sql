@@ -0,0 +1,9 @@
+CREATE TABLE handling_events (
+	id             INTEGER PRIMARY KEY AUTOINCREMENT,
+	tracking_id    TEXT NOT NULL,
+	type           INTEGER NOT NULL,
+	location       TEXT NOT NULL,
+	voyage_number  TEXT NOT NULL
+);
+
+CREATE INDEX handling_events_tracking_id ON handling_events (tracking_id);
@@ -0,0 +1,4 @@
+ALTER TABLE handling_events ADD COLUMN completed TIMESTAMP NOT NULL DEFAULT '0001-01-01 00:00:00+00:00';
+ALTER TABLE handling_events ADD COLUMN registered TIMESTAMP NOT NULL DEFAULT '0001-01-01 00:00:00+00:00';
+ALTER TABLE cargos ADD COLUMN last_event_completed TIMESTAMP NOT NULL DEFAULT '0001-01-01 00:00:00+00:00';
+ALTER TABLE cargos ADD COLUMN last_event_registered TIMESTAMP NOT NULL DEFAULT '0001-01-01 00:00:00+00:00';
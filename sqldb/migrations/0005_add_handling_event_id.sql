@@ -0,0 +1,2 @@
+ALTER TABLE handling_events ADD COLUMN event_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE cargos ADD COLUMN last_event_id TEXT NOT NULL DEFAULT '';
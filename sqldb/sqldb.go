@@ -0,0 +1,286 @@
+// Package sqldb provides database/sql implementations of the cargo and
+// handling event repositories. Queries are written for SQLite, which is what
+// the embedded migrations target.
+package sqldb
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/go-kit/kit/log"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+// Open opens the database with the given driver and data source name, and
+// applies any pending migrations.
+func Open(driver, dsn string) (*sql.DB, error) {
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := Migrate(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+type cargoRepository struct {
+	db     *sql.DB
+	logger log.Logger
+}
+
+func (r *cargoRepository) Store(c *cargo.Cargo) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Bumping the version first takes the write lock before anything is
+	// read, so two concurrent stores cannot both pass the check.
+	res, err := tx.Exec(`UPDATE cargos SET version = version + 1 WHERE tracking_id = ? AND version = ?`, c.TrackingID, c.Version)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		var current int
+		err := tx.QueryRow(`SELECT version FROM cargos WHERE tracking_id = ?`, c.TrackingID).Scan(&current)
+		switch {
+		case err == sql.ErrNoRows && c.Version == 0:
+			// A new cargo.
+		case err != nil && err != sql.ErrNoRows:
+			return err
+		default:
+			return &cargo.ConflictError{TrackingID: c.TrackingID, Expected: c.Version, Actual: current}
+		}
+	}
+
+	for _, q := range []string{
+		`DELETE FROM itinerary_legs WHERE tracking_id = ?`,
+		`DELETE FROM route_specifications WHERE tracking_id = ?`,
+		`DELETE FROM cargos WHERE tracking_id = ?`,
+	} {
+		if _, err := tx.Exec(q, c.TrackingID); err != nil {
+			return err
+		}
+	}
+
+	d := c.Delivery
+	if _, err := tx.Exec(`INSERT INTO cargos (
+		tracking_id, origin, routing_status, transport_status,
+		last_event_type, last_event_location, last_event_voyage,
+		last_event_completed, last_event_registered, last_event_id,
+		last_known_location, current_voyage,
+		next_activity_type, next_activity_location, next_activity_voyage,
+		eta, is_misdirected, is_unloaded_at_destination, version
+	) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		c.TrackingID, c.Origin, d.RoutingStatus, d.TransportStatus,
+		d.LastEvent.Activity.Type, d.LastEvent.Activity.Location, d.LastEvent.Activity.VoyageNumber,
+		d.LastEvent.Completed, d.LastEvent.Registered, d.LastEvent.ID,
+		d.LastKnownLocation, d.CurrentVoyage,
+		d.NextExpectedActivity.Type, d.NextExpectedActivity.Location, d.NextExpectedActivity.VoyageNumber,
+		d.ETA, d.IsMisdirected, d.IsUnloadedAtDestination, c.Version+1,
+	); err != nil {
+		return err
+	}
+
+	rs := c.RouteSpecification
+	if _, err := tx.Exec(`INSERT INTO route_specifications (tracking_id, origin, destination, deadline) VALUES (?, ?, ?, ?)`,
+		c.TrackingID, rs.Origin, rs.Destination, rs.Deadline,
+	); err != nil {
+		return err
+	}
+
+	for i, l := range c.Itinerary.Legs {
+		if _, err := tx.Exec(`INSERT INTO itinerary_legs (
+			tracking_id, position, voyage_number, load_location, unload_location, load_time, unload_time
+		) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			c.TrackingID, i, l.VoyageNumber, l.LoadLocation, l.UnLoadLocation, l.LoadTime, l.UnLoadTime,
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	c.Version++
+	return nil
+}
+
+func (r *cargoRepository) Find(id cargo.TrackingID) (*cargo.Cargo, error) {
+	c, err := r.find(id)
+	if err == sql.ErrNoRows {
+		return nil, cargo.ErrUnknown
+	}
+	return c, err
+}
+
+func (r *cargoRepository) find(id cargo.TrackingID) (*cargo.Cargo, error) {
+	var (
+		c  = &cargo.Cargo{TrackingID: id}
+		d  cargo.Delivery
+		rs cargo.RouteSpecification
+	)
+
+	if err := r.db.QueryRow(`SELECT
+		origin, routing_status, transport_status,
+		last_event_type, last_event_location, last_event_voyage,
+		last_event_completed, last_event_registered, last_event_id,
+		last_known_location, current_voyage,
+		next_activity_type, next_activity_location, next_activity_voyage,
+		eta, is_misdirected, is_unloaded_at_destination, version
+	FROM cargos WHERE tracking_id = ?`, id).Scan(
+		&c.Origin, &d.RoutingStatus, &d.TransportStatus,
+		&d.LastEvent.Activity.Type, &d.LastEvent.Activity.Location, &d.LastEvent.Activity.VoyageNumber,
+		&d.LastEvent.Completed, &d.LastEvent.Registered, &d.LastEvent.ID,
+		&d.LastKnownLocation, &d.CurrentVoyage,
+		&d.NextExpectedActivity.Type, &d.NextExpectedActivity.Location, &d.NextExpectedActivity.VoyageNumber,
+		&d.ETA, &d.IsMisdirected, &d.IsUnloadedAtDestination, &c.Version,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := r.db.QueryRow(`SELECT origin, destination, deadline FROM route_specifications WHERE tracking_id = ?`, id).Scan(
+		&rs.Origin, &rs.Destination, &rs.Deadline,
+	); err != nil {
+		return nil, err
+	}
+
+	legs, err := r.legs(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.LastEvent.Activity.Type != cargo.NotHandled {
+		d.LastEvent.TrackingID = id
+	}
+
+	c.RouteSpecification = rs
+	c.Itinerary = cargo.Itinerary{Legs: legs}
+	d.RouteSpecification = rs
+	d.Itinerary = c.Itinerary
+	c.Delivery = d
+
+	return c, nil
+}
+
+func (r *cargoRepository) legs(id cargo.TrackingID) ([]cargo.Leg, error) {
+	rows, err := r.db.Query(`SELECT voyage_number, load_location, unload_location, load_time, unload_time
+	FROM itinerary_legs WHERE tracking_id = ? ORDER BY position`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var legs []cargo.Leg
+	for rows.Next() {
+		var (
+			voyageNumber         voyage.Number
+			from, to             location.UNLcode
+			loadTime, unloadTime time.Time
+		)
+		if err := rows.Scan(&voyageNumber, &from, &to, &loadTime, &unloadTime); err != nil {
+			return nil, err
+		}
+		legs = append(legs, cargo.NewLeg(voyageNumber, from, to, loadTime, unloadTime))
+	}
+	return legs, rows.Err()
+}
+
+func (r *cargoRepository) FindAll() []*cargo.Cargo {
+	c := make([]*cargo.Cargo, 0)
+
+	ids, err := r.trackingIDs()
+	if err != nil {
+		r.logger.Log("method", "find_all", "err", err)
+		return c
+	}
+	for _, id := range ids {
+		val, err := r.find(id)
+		if err != nil {
+			r.logger.Log("method", "find_all", "tracking_id", id, "err", err)
+			continue
+		}
+		c = append(c, val)
+	}
+	return c
+}
+
+func (r *cargoRepository) trackingIDs() ([]cargo.TrackingID, error) {
+	rows, err := r.db.Query(`SELECT tracking_id FROM cargos ORDER BY tracking_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []cargo.TrackingID
+	for rows.Next() {
+		var id cargo.TrackingID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// NewCargoRepository returns a new instance of a SQL cargo repository. The
+// schema must have been migrated, see Migrate. Errors that the repository
+// interface cannot return are logged.
+func NewCargoRepository(db *sql.DB, logger log.Logger) cargo.Repository {
+	return &cargoRepository{db: db, logger: logger}
+}
+
+type handlingEventRepository struct {
+	db     *sql.DB
+	logger log.Logger
+}
+
+func (r *handlingEventRepository) Store(e cargo.HandlingEvent) {
+	if _, err := r.db.Exec(`INSERT INTO handling_events (event_id, tracking_id, type, location, voyage_number, completed, registered) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		e.ID, e.TrackingID, e.Activity.Type, e.Activity.Location, e.Activity.VoyageNumber, e.Completed, e.Registered,
+	); err != nil {
+		r.logger.Log("method", "store", "tracking_id", e.TrackingID, "err", err)
+	}
+}
+
+func (r *handlingEventRepository) QueryHandlingHistory(id cargo.TrackingID) cargo.HandlingHistory {
+	events, err := r.events(id)
+	if err != nil {
+		r.logger.Log("method", "query_handling_history", "tracking_id", id, "err", err)
+		return cargo.NewHandlingHistory(nil)
+	}
+	return cargo.NewHandlingHistory(events)
+}
+
+func (r *handlingEventRepository) events(id cargo.TrackingID) ([]cargo.HandlingEvent, error) {
+	rows, err := r.db.Query(`SELECT event_id, type, location, voyage_number, completed, registered FROM handling_events WHERE tracking_id = ? ORDER BY id`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []cargo.HandlingEvent
+	for rows.Next() {
+		e := cargo.HandlingEvent{TrackingID: id}
+		if err := rows.Scan(&e.ID, &e.Activity.Type, &e.Activity.Location, &e.Activity.VoyageNumber, &e.Completed, &e.Registered); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+// NewHandlingEventRepository returns a new instance of a SQL handling event
+// repository. The schema must have been migrated, see Migrate. Errors that
+// the repository interface cannot return are logged.
+func NewHandlingEventRepository(db *sql.DB, logger log.Logger) cargo.HandlingEventRepository {
+	return &handlingEventRepository{db: db, logger: logger}
+}
@@ -0,0 +1,144 @@
+package sqldb
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	_ "modernc.org/sqlite"
+
+	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/voyage"
+)
+
+func openTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := Open("sqlite", filepath.Join(t.TempDir(), "shipping.sqlite")+"?_pragma=busy_timeout(5000)&_time_format=sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestMigrate(t *testing.T) {
+	db := openTestDB(t)
+
+	ms, err := loadMigrations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != len(ms) {
+		t.Errorf("%d migrations applied, want %d", n, len(ms))
+	}
+
+	// Migrating again is a no-op.
+	if err := Migrate(db); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.QueryRow(`SELECT COUNT(*) FROM schema_migrations`).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != len(ms) {
+		t.Errorf("%d migrations applied after migrating again, want %d", n, len(ms))
+	}
+}
+
+func TestCargoRepository(t *testing.T) {
+	r := NewCargoRepository(openTestDB(t), log.NewNopLogger())
+
+	t0 := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := cargo.New("ABC123", cargo.RouteSpecification{
+		Origin:      location.SESTO,
+		Destination: location.CNHKG,
+		Deadline:    t0.Add(30 * 24 * time.Hour),
+	})
+	c.AssignToRoute(cargo.Itinerary{Legs: []cargo.Leg{
+		cargo.NewLeg(voyage.V100.Number, location.SESTO, location.USNYC, t0, t0.Add(24*time.Hour)),
+		cargo.NewLeg(voyage.V300.Number, location.USNYC, location.CNHKG, t0.Add(48*time.Hour), t0.Add(96*time.Hour)),
+	}})
+	if err := r.Store(c); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := r.Find("ABC123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found.RouteSpecification.Destination != location.CNHKG || !found.RouteSpecification.Deadline.Equal(c.RouteSpecification.Deadline) {
+		t.Errorf("found route specification %+v, want %+v", found.RouteSpecification, c.RouteSpecification)
+	}
+	if len(found.Itinerary.Legs) != 2 || found.Itinerary.Legs[1].UnLoadLocation != location.CNHKG {
+		t.Errorf("found itinerary %+v, want %+v", found.Itinerary, c.Itinerary)
+	}
+	if found.Delivery.RoutingStatus != c.Delivery.RoutingStatus || found.Version != c.Version {
+		t.Errorf("found %+v, want %+v", found, c)
+	}
+
+	if _, err := r.Find("NOPE"); err != cargo.ErrUnknown {
+		t.Errorf("finding an unknown cargo: got %v, want %v", err, cargo.ErrUnknown)
+	}
+	if n := len(r.FindAll()); n != 1 {
+		t.Errorf("found %d cargos, want 1", n)
+	}
+}
+
+func TestCargoRepositoryVersionConflict(t *testing.T) {
+	r := NewCargoRepository(openTestDB(t), log.NewNopLogger())
+
+	c := cargo.New("ABC123", cargo.RouteSpecification{
+		Origin:      location.SESTO,
+		Destination: location.CNHKG,
+		Deadline:    time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err := r.Store(c); err != nil {
+		t.Fatal(err)
+	}
+
+	first, _ := r.Find("ABC123")
+	second, _ := r.Find("ABC123")
+	if err := r.Store(first); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Store(second); !cargo.IsConflict(err) {
+		t.Errorf("storing a stale cargo: got %v, want a conflict", err)
+	}
+
+	// A new cargo whose tracking ID is already taken conflicts too.
+	if err := r.Store(cargo.New("ABC123", c.RouteSpecification)); !cargo.IsConflict(err) {
+		t.Errorf("storing a duplicate cargo: got %v, want a conflict", err)
+	}
+}
+
+func TestHandlingEventRepository(t *testing.T) {
+	r := NewHandlingEventRepository(openTestDB(t), log.NewNopLogger())
+
+	t0 := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := cargo.HandlingEvent{ID: "2", TrackingID: "ABC123", Activity: cargo.HandlingActivity{Type: cargo.Unload, Location: location.CNHKG, VoyageNumber: voyage.V100.Number}, Completed: t0.Add(48 * time.Hour), Registered: t0}
+	early := cargo.HandlingEvent{ID: "1", TrackingID: "ABC123", Activity: cargo.HandlingActivity{Type: cargo.Receive, Location: location.SESTO}, Completed: t0, Registered: t0.Add(72 * time.Hour)}
+	other := cargo.HandlingEvent{ID: "3", TrackingID: "FTL456", Activity: cargo.HandlingActivity{Type: cargo.Receive, Location: location.AUMEL}, Completed: t0, Registered: t0}
+	r.Store(late)
+	r.Store(early)
+	r.Store(other)
+
+	h := r.QueryHandlingHistory("ABC123")
+	if len(h.HandlingEvents) != 2 {
+		t.Fatalf("got %d events, want 2", len(h.HandlingEvents))
+	}
+	if h.HandlingEvents[0].ID != early.ID || h.HandlingEvents[1].ID != late.ID {
+		t.Errorf("events not ordered by completion time: %+v", h.HandlingEvents)
+	}
+	if got := h.HandlingEvents[1]; got.Activity != late.Activity || !got.Completed.Equal(late.Completed) || !got.Registered.Equal(late.Registered) {
+		t.Errorf("got %+v, want %+v", got, late)
+	}
+	if n := len(r.QueryHandlingHistory("NOPE").HandlingEvents); n != 0 {
+		t.Errorf("got %d events for an unknown cargo, want 0", n)
+	}
+}
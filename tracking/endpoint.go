@@ -2,6 +2,7 @@ package tracking
 
 import (
 	"context"
+	"errors"
 
 	"golang.org/x/time/rate"
 
@@ -19,6 +20,7 @@ import (
 
 type trackCargoRequest struct {
 	ID string
+	TZ string
 }
 
 type trackCargoResponse struct {
@@ -31,7 +33,7 @@ func (r trackCargoResponse) error() error { return r.Err }
 func makeTrackCargoEndpoint(ts Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(trackCargoRequest)
-		c, err := ts.Track(req.ID)
+		c, err := ts.Track(req.ID, req.TZ)
 		return trackCargoResponse{Cargo: &c, Err: err}, nil
 	}
 }
@@ -39,6 +41,10 @@ func makeTrackCargoEndpoint(ts Service) endpoint.Endpoint {
 // Set collects all of the endpoints that compose a handling cargo service.
 type Set struct {
 	TrackCargoEndpoint endpoint.Endpoint
+
+	// WatchFunc watches cargos. Streams do not fit an endpoint, so it is the
+	// Watch of the service, or of a grpc client.
+	WatchFunc func(ctx context.Context, ids []string, tz string, after uint64) (<-chan Update, error)
 }
 
 // NewSet returns a Set that wraps the provided server, and wires in all of the
@@ -55,15 +61,27 @@ func NewSet(svc Service, logger log.Logger, duration metrics.Histogram, otTracer
 	}
 	return Set{
 		TrackCargoEndpoint: trackCargoEndpoint,
+		WatchFunc:          svc.Watch,
 	}
 }
 
 // Track implements the service interface so Set can be used as a service
-func(s Set) Track(id string) (Cargo, error) {
-	resp, err := s.TrackCargoEndpoint(context.Background(), trackCargoRequest{ID: id})
+func(s Set) Track(id string, tz string) (Cargo, error) {
+	resp, err := s.TrackCargoEndpoint(context.Background(), trackCargoRequest{ID: id, TZ: tz})
 	if err != nil {
 		return Cargo{}, err
 	}
 	response := resp.(trackCargoResponse)
 	return *response.Cargo, response.Err
-}
\ No newline at end of file
+}
+
+// errWatchUnsupported is returned by a Set without a WatchFunc.
+var errWatchUnsupported = errors.New("watching cargos is not supported")
+
+// Watch implements the service interface so Set can be used as a service
+func(s Set) Watch(ctx context.Context, ids []string, tz string, after uint64) (<-chan Update, error) {
+	if s.WatchFunc == nil {
+		return nil, errWatchUnsupported
+	}
+	return s.WatchFunc(ctx, ids, tz, after)
+}
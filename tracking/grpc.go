@@ -6,7 +6,11 @@ import (
 	"time"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
+	"github.com/Qalifah/shipping/cargo"
 	pb "github.com/Qalifah/shipping/pb/trackingpb"
 
 	"github.com/go-kit/kit/circuitbreaker"
@@ -21,12 +25,14 @@ import (
 	stdopentracing "github.com/opentracing/opentracing-go"
 	stdzipkin "github.com/openzipkin/zipkin-go"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/sony/gobreaker"
 	"golang.org/x/time/rate"
 )
 
 type grpcServer struct {
 	trackCargo	grpctransport.Handler
+	watch		func(ctx context.Context, ids []string, tz string, after uint64) (<-chan Update, error)
 }
 
 // NewGRPCServer makes a set of endpoints available on a grpc server
@@ -46,6 +52,7 @@ func NewGRPCServer(endpoints Set, otTracer stdopentracing.Tracer, zipkinTracer *
 			encodeGRPCTrackCargoResponse,
 			append(options, grpctransport.ServerBefore(opentracing.GRPCToContext(otTracer, "trackCargo", logger)))...,
 	    ),
+		watch: endpoints.Watch,
 	}
 }
 
@@ -57,6 +64,46 @@ func(s *grpcServer) Track(ctx context.Context, req *pb.TrackRequest) (*pb.TrackR
 	return rep.(*pb.TrackReply), nil
 }
 
+func(s *grpcServer) WatchCargo(req *pb.WatchCargoRequest, stream pb.Tracking_WatchCargoServer) error {
+	return s.serveWatch([]string{req.TrackingId}, req.Tz, req.After, stream)
+}
+
+func(s *grpcServer) WatchCargos(req *pb.WatchCargosRequest, stream pb.Tracking_WatchCargosServer) error {
+	return s.serveWatch(req.TrackingIds, req.Tz, req.After, stream)
+}
+
+// watchingHeader is sent once a watch has started, so that clients can tell
+// a stream without updates so far from one that failed to start.
+const watchingHeader = "x-watching"
+
+// watchStream is what the streams of WatchCargo and WatchCargos have in
+// common.
+type watchStream interface {
+	Context() context.Context
+	SendHeader(metadata.MD) error
+	Send(*pb.WatchReply) error
+}
+
+func(s *grpcServer) serveWatch(ids []string, tz string, after uint64, stream watchStream) error {
+	ctx := stream.Context()
+	updates, err := s.watch(ctx, ids, tz, after)
+	if err != nil {
+		return encodeGRPCWatchError(err)
+	}
+	if err := stream.SendHeader(metadata.Pairs(watchingHeader, "true")); err != nil {
+		return err
+	}
+	for u := range updates {
+		if err := stream.Send(&pb.WatchReply{Seq: u.Seq, Cargo: encodeCargo(u.Cargo)}); err != nil {
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return status.Error(codes.Aborted, "watcher fell behind, resume after the last seq received")
+}
+
 // NewGRPCClient returns a booking service backed by a grpc server at the other end of the conn
 func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkinTracer *stdzipkin.Tracer, logger log.Logger) Service {
 	limiter := ratelimit.NewErroringLimiter(rate.NewLimiter(rate.Every(time.Second), 100))
@@ -69,8 +116,8 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 	{
 		trackCargoEndpoint = grpctransport.NewClient(
 			conn,
-			"pb.tracking",
-			"TrackCargo",
+			"trackingpb.Tracking",
+			"Track",
 			encodeGRPCTrackCargoRequest,
 			decodeGRPCTrackCargoResponse,
 			pb.TrackReply{},
@@ -86,12 +133,57 @@ func NewGRPCClient(conn *grpc.ClientConn, otTracer stdopentracing.Tracer, zipkin
 	
 	return Set{
 		TrackCargoEndpoint: trackCargoEndpoint,
+		WatchFunc:          newGRPCWatchFunc(pb.NewTrackingClient(conn)),
+	}
+}
+
+// newGRPCWatchFunc returns a WatchFunc that watches cargos through the
+// WatchCargos stream of the client.
+func newGRPCWatchFunc(client pb.TrackingClient) func(ctx context.Context, ids []string, tz string, after uint64) (<-chan Update, error) {
+	return func(ctx context.Context, ids []string, tz string, after uint64) (<-chan Update, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		stream, err := client.WatchCargos(ctx, &pb.WatchCargosRequest{TrackingIds: ids, Tz: tz, After: after})
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		md, err := stream.Header()
+		if err != nil {
+			cancel()
+			return nil, decodeGRPCWatchError(err)
+		}
+		if len(md.Get(watchingHeader)) == 0 {
+			_, err := stream.Recv()
+			cancel()
+			if err == nil {
+				err = errors.New("watch did not start")
+			}
+			return nil, decodeGRPCWatchError(err)
+		}
+
+		updates := make(chan Update)
+		go func() {
+			defer cancel()
+			defer close(updates)
+			for {
+				reply, err := stream.Recv()
+				if err != nil {
+					return
+				}
+				select {
+				case updates <- Update{Seq: reply.Seq, Cargo: *decodeCargo(reply.Cargo)}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return updates, nil
 	}
 }
 
 func decodeGRPCTrackCargoRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
 	req := grpcReq.(*pb.TrackRequest)
-	return trackCargoRequest{ID: req.TrackingId}, nil
+	return trackCargoRequest{ID: req.TrackingId, TZ: req.Tz}, nil
 }
 
 func encodeGRPCTrackCargoResponse(_ context.Context, response interface{}) (interface{}, error) {
@@ -106,7 +198,29 @@ func decodeGRPCTrackCargoResponse(_ context.Context, grpcReply interface{}) (int
 
 func encodeGRPCTrackCargoRequest(_ context.Context, request interface{}) (interface{}, error) {
 	req := request.(trackCargoRequest)
-	return &pb.TrackRequest{TrackingId: req.ID}, nil
+	return &pb.TrackRequest{TrackingId: req.ID, Tz: req.TZ}, nil
+}
+
+func encodeGRPCWatchError(err error) error {
+	switch err {
+	case cargo.ErrUnknown:
+		return status.Error(codes.NotFound, err.Error())
+	case ErrInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func decodeGRPCWatchError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return cargo.ErrUnknown
+	case codes.InvalidArgument:
+		return ErrInvalidArgument
+	default:
+		return err
+	}
 }
 
 func encodeCargo(decodedCargo Cargo) *pb.Cargo {
@@ -121,6 +235,7 @@ func encodeCargo(decodedCargo Cargo) *pb.Cargo {
 		NextExpectedActivity: decodedCargo.NextExpectedActivity,
 		Deadline: deadline,
 		Events: encodeEvents(decodedCargo.Events),
+		TimeZone: decodedCargo.TimeZone,
 	}
 	return encodedCargo
 }
@@ -128,14 +243,24 @@ func encodeCargo(decodedCargo Cargo) *pb.Cargo {
 func encodeEvents(decodedEvents []Event) []*pb.Event {
 	var events []*pb.Event
 	for _, event := range decodedEvents {
-		events = append(events, &pb.Event{Description: event.Description, Expected: event.Expected})
+		completionTime, _ := ptypes.TimestampProto(event.CompletionTime)
+		registrationTime, _ := ptypes.TimestampProto(event.RegistrationTime)
+		events = append(events, &pb.Event{
+			Description: event.Description,
+			Expected: event.Expected,
+			CompletionTime: completionTime,
+			RegistrationTime: registrationTime,
+			Location: event.Location,
+			TimeZone: event.TimeZone,
+		})
 	}
 	return events
 }
 
 func decodeCargo(encodedCargo *pb.Cargo) *Cargo {
-	eta, _ := ptypes.Timestamp(encodedCargo.Eta)
-	deadline, _ := ptypes.Timestamp(encodedCargo.Deadline)
+	tz := loadZone(encodedCargo.TimeZone)
+	eta := decodeTimestamp(encodedCargo.Eta, tz)
+	deadline := decodeTimestamp(encodedCargo.Deadline, tz)
 	decodedCargo := &Cargo{
 		TrackingID: encodedCargo.Id,
 		StatusText: encodedCargo.StatusText,
@@ -144,6 +269,7 @@ func decodeCargo(encodedCargo *pb.Cargo) *Cargo {
 		ETA: eta,
 		NextExpectedActivity: encodedCargo.NextExpectedActivity,
 		ArrivalDeadline: deadline,
+		TimeZone: encodedCargo.TimeZone,
         Events: decodeEvents(encodedCargo.Events),
 	}
 	return decodedCargo
@@ -152,11 +278,39 @@ func decodeCargo(encodedCargo *pb.Cargo) *Cargo {
 func decodeEvents(encodedEvents []*pb.Event) []Event {
 	var events []Event
 	for _, event := range encodedEvents {
-		events = append(events, Event{Description: event.Description, Expected: event.Expected})
+		tz := loadZone(event.TimeZone)
+		events = append(events, Event{
+			Description: event.Description,
+			Expected: event.Expected,
+			Location: event.Location,
+			CompletionTime: decodeTimestamp(event.CompletionTime, tz),
+			RegistrationTime: decodeTimestamp(event.RegistrationTime, tz),
+			TimeZone: event.TimeZone,
+		})
 	}
 	return events
 }
 
+// loadZone returns the named time zone, or UTC if it is unknown. Timestamps
+// carry no time zone, so it is sent alongside them.
+func loadZone(name string) *time.Location {
+	tz, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+	return tz
+}
+
+// decodeTimestamp returns the time of the timestamp in the time zone, or the
+// zero time if it is zero.
+func decodeTimestamp(ts *timestamp.Timestamp, tz *time.Location) time.Time {
+	t, _ := ptypes.Timestamp(ts)
+	if t.IsZero() {
+		return t
+	}
+	return t.In(tz)
+}
+
 func err2str(err error) string {
 	if err == nil {
 		return ""
@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 
@@ -32,6 +35,7 @@ func MakeHandler(ts Service, logger kitlog.Logger) http.Handler {
 	)
 
 	r.Handle("/tracking/v1/cargos/{id}", trackCargoHandler).Methods("GET")
+	r.Handle("/tracking/v1/cargos/{id}/events/stream", streamCargoHandler(ts, logger)).Methods("GET")
 
 	return r
 }
@@ -42,7 +46,78 @@ func decodeTrackCargoRequest(_ context.Context, r *http.Request) (interface{}, e
 	if !ok {
 		return nil, errors.New("bad route")
 	}
-	return trackCargoRequest{ID: id}, nil
+	return trackCargoRequest{ID: id, TZ: r.URL.Query().Get("tz")}, nil
+}
+
+// keepAlive is how often a comment is sent on an idle event stream, so that
+// proxies do not time it out.
+const keepAlive = 15 * time.Second
+
+// streamCargoHandler serves a cargo as a stream of Server-Sent Events, each
+// the tracking view of the cargo after it was handled. The ID of each event is
+// its sequence number, so that a reconnecting client resumes where it left
+// off through the Last-Event-ID header, or the last_event_id parameter.
+func streamCargoHandler(ts Service, logger kitlog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			encodeError(ctx, errors.New("streaming unsupported"), w)
+			return
+		}
+
+		id := mux.Vars(r)["id"]
+		lastEventID := r.Header.Get("Last-Event-ID")
+		if lastEventID == "" {
+			lastEventID = r.URL.Query().Get("last_event_id")
+		}
+		var after uint64
+		if lastEventID != "" {
+			var err error
+			if after, err = strconv.ParseUint(lastEventID, 10, 64); err != nil {
+				encodeError(ctx, ErrInvalidArgument, w)
+				return
+			}
+		}
+
+		updates, err := ts.Watch(ctx, []string{id}, r.URL.Query().Get("tz"), after)
+		if err != nil {
+			encodeError(ctx, err, w)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		ticker := time.NewTicker(keepAlive)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case u, ok := <-updates:
+				if !ok {
+					return
+				}
+				b, err := json.Marshal(u.Cargo)
+				if err != nil {
+					logger.Log("tracking_id", id, "err", err)
+					return
+				}
+				if _, err := fmt.Fprintf(w, "id: %d\nevent: cargo\ndata: %s\n\n", u.Seq, b); err != nil {
+					return
+				}
+			case <-ticker.C:
+				if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+					return
+				}
+			}
+			flusher.Flush()
+		}
+	})
 }
 
 func encodeResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
@@ -1,6 +1,7 @@
 package tracking
 
 import (
+	"context"
 	"time"
 
 	"github.com/go-kit/kit/metrics"
@@ -21,11 +22,20 @@ func NewInstrumentingService(counter metrics.Counter, latency metrics.Histogram,
 	}
 }
 
-func (s *instrumentingService) Track(id string) (Cargo, error) {
+func (s *instrumentingService) Track(id string, tz string) (Cargo, error) {
 	defer func(begin time.Time) {
 		s.requestCount.With("method", "track").Add(1)
 		s.requestLatency.With("method", "track").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return s.Service.Track(id)
-}
\ No newline at end of file
+	return s.Service.Track(id, tz)
+}
+
+func (s *instrumentingService) Watch(ctx context.Context, ids []string, tz string, after uint64) (<-chan Update, error) {
+	defer func(begin time.Time) {
+		s.requestCount.With("method", "watch").Add(1)
+		s.requestLatency.With("method", "watch").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return s.Service.Watch(ctx, ids, tz, after)
+}
@@ -1,6 +1,8 @@
 package tracking
 
 import (
+	"context"
+	"strings"
 	"time"
 
 	"github.com/go-kit/kit/log"
@@ -16,9 +18,16 @@ func NewLoggingService(logger log.Logger, s Service) Service {
 	return &loggingService{logger, s}
 }
 
-func (s *loggingService) Track(id string) (c Cargo, err error) {
+func (s *loggingService) Track(id string, tz string) (c Cargo, err error) {
 	defer func(begin time.Time) {
-		s.logger.Log("method", "track", "tracking_id", id, "took", time.Since(begin), "err", err)
+		s.logger.Log("method", "track", "tracking_id", id, "tz", tz, "took", time.Since(begin), "err", err)
 	}(time.Now())
-	return s.Service.Track(id)
-}
\ No newline at end of file
+	return s.Service.Track(id, tz)
+}
+
+func (s *loggingService) Watch(ctx context.Context, ids []string, tz string, after uint64) (updates <-chan Update, err error) {
+	defer func(begin time.Time) {
+		s.logger.Log("method", "watch", "tracking_ids", strings.Join(ids, ","), "tz", tz, "after", after, "took", time.Since(begin), "err", err)
+	}(time.Now())
+	return s.Service.Watch(ctx, ids, tz, after)
+}
@@ -1,12 +1,15 @@
 package tracking
 
 import (
+	"context"
 	"errors"
 	"time"
 	"strings"
 	"fmt"
 
 	"github.com/Qalifah/shipping/cargo"
+	"github.com/Qalifah/shipping/location"
+	"github.com/Qalifah/shipping/pubsub"
 )
 
 // ErrInvalidArgument is returned when one or more arguments are invalid
@@ -15,34 +18,162 @@ var ErrInvalidArgument = errors.New("invalid argument")
 // Service provides access to basic Track methods
 type Service interface {
 
-	// Track returns the cargo matching the tracking ID
-	Track(id string) (Cargo, error)
+	// Track returns the cargo matching the tracking ID. Times are in the
+	// local time zone of the location they relate to, or in the IANA time
+	// zone named by tz if it is not empty.
+	Track(id string, tz string) (Cargo, error)
+
+	// Watch sends a snapshot of each of the cargos whenever it is handled,
+	// numbered with the sequence number of the handling event, until ctx is
+	// done. Watching resumes after the given sequence number if it is not
+	// zero and the events since are still known; the current snapshots are
+	// sent first otherwise. The channel is also closed if the watcher falls
+	// too far behind, after which it can resume from its last update.
+	Watch(ctx context.Context, ids []string, tz string, after uint64) (<-chan Update, error)
 }
 
 type service struct {
 	cargos		cargo.Repository
 	handlingEvents	cargo.HandlingEventRepository
+	locations	location.Repository
+	hub		*pubsub.Hub
 }
 
-func(s *service) Track(id string) (Cargo, error) {
+func(s *service) Track(id string, tz string) (Cargo, error) {
 	if id == "" {
 		return Cargo{}, ErrInvalidArgument
 	}
+	z, err := s.zones(tz)
+	if err != nil {
+		return Cargo{}, err
+	}
 	c, err := s.cargos.Find(cargo.TrackingID(id))
 	if err != nil {
 		return Cargo{}, err
 	}
-	return assemble(c, s.handlingEvents), nil
+	return assemble(c, s.handlingEvents, z), nil
+}
+
+func(s *service) Watch(ctx context.Context, ids []string, tz string, after uint64) (<-chan Update, error) {
+	if len(ids) == 0 {
+		return nil, ErrInvalidArgument
+	}
+	z, err := s.zones(tz)
+	if err != nil {
+		return nil, err
+	}
+
+	var trackingIDs []cargo.TrackingID
+	seen := make(map[cargo.TrackingID]bool)
+	for _, id := range ids {
+		if id == "" {
+			return nil, ErrInvalidArgument
+		}
+		if seen[cargo.TrackingID(id)] {
+			continue
+		}
+		if _, err := s.cargos.Find(cargo.TrackingID(id)); err != nil {
+			return nil, err
+		}
+		seen[cargo.TrackingID(id)] = true
+		trackingIDs = append(trackingIDs, cargo.TrackingID(id))
+	}
+
+	snapshot := after == 0
+	sub, err := s.hub.Subscribe(trackingIDs, after)
+	if err == pubsub.ErrUnknownSequence {
+		snapshot = true
+		sub, err = s.hub.Subscribe(trackingIDs, 0)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	updates := make(chan Update)
+	go func() {
+		defer close(updates)
+		defer sub.Close()
+
+		send := func(seq uint64, id cargo.TrackingID) bool {
+			c, err := s.cargos.Find(id)
+			if err != nil {
+				return true
+			}
+			select {
+			case updates <- Update{Seq: seq, Cargo: assemble(c, s.handlingEvents, z)}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if snapshot {
+			for _, id := range trackingIDs {
+				if !send(sub.Start, id) {
+					return
+				}
+			}
+		}
+		for {
+			select {
+			case m, ok := <-sub.C():
+				if !ok || !send(m.Seq, m.Event.TrackingID) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
+// zones returns the time zones to render times in for a viewer in the IANA
+// time zone named by tz, if it is not empty.
+func(s *service) zones(tz string) (zones, error) {
+	z := zones{locations: s.locations}
+	if tz != "" {
+		viewer, err := time.LoadLocation(tz)
+		if err != nil {
+			return zones{}, ErrInvalidArgument
+		}
+		z.viewer = viewer
+	}
+	return z, nil
 }
 
-// NewService returns a new instance of the default Service.
-func NewService(cargos cargo.Repository, events cargo.HandlingEventRepository) Service {
+// NewService returns a new instance of the default Service. Cargos are
+// watched through the handling events published to hub.
+func NewService(cargos cargo.Repository, events cargo.HandlingEventRepository, locations location.Repository, hub *pubsub.Hub) Service {
 	return &service{
 		cargos:         cargos,
 		handlingEvents: events,
+		locations:      locations,
+		hub:            hub,
 	}
 }
 
+// zones resolves the time zones that times are rendered in.
+type zones struct {
+	locations	location.Repository
+	viewer		*time.Location
+}
+
+// of returns the time zone for times at a location: the viewer's if one was
+// requested, otherwise the location's own, or UTC if it is not known.
+func(z zones) of(code location.UNLcode) *time.Location {
+	if z.viewer != nil {
+		return z.viewer
+	}
+	if l, err := z.locations.Find(code); err == nil && l.TimeZone != "" {
+		if tz, err := time.LoadLocation(l.TimeZone); err == nil {
+			return tz
+		}
+	}
+	return time.UTC
+}
+
 // Cargo is a read model for tracking views.
 type Cargo struct {
 	TrackingID           string    `json:"tracking_id"`
@@ -52,13 +183,25 @@ type Cargo struct {
 	ETA                  time.Time `json:"eta"`
 	NextExpectedActivity string    `json:"next_expected_activity"`
 	ArrivalDeadline      time.Time `json:"arrival_deadline"`
+	TimeZone             string    `json:"time_zone"`
 	Events               []Event   `json:"events"`
 }
 
+// Update is a snapshot of a watched cargo, numbered with the sequence number
+// of the handling event that prompted it.
+type Update struct {
+	Seq   uint64 `json:"seq"`
+	Cargo Cargo  `json:"cargo"`
+}
+
 // Event is a read model for tracking views.
 type Event struct {
-	Description string `json:"description"`
-	Expected    bool   `json:"expected"`
+	Description      string    `json:"description"`
+	Expected         bool      `json:"expected"`
+	Location         string    `json:"location"`
+	CompletionTime   time.Time `json:"completion_time"`
+	RegistrationTime time.Time `json:"registration_time"`
+	TimeZone         string    `json:"time_zone"`
 }
 
 // Leg is a read model for booking views.
@@ -71,19 +214,32 @@ type Leg struct {
 }
 
 
-func assemble(c *cargo.Cargo, events cargo.HandlingEventRepository) Cargo {
+// assemble renders the ETA and arrival deadline in the time zone of the
+// destination, and each event in the time zone of its location.
+func assemble(c *cargo.Cargo, events cargo.HandlingEventRepository, z zones) Cargo {
+	tz := z.of(c.RouteSpecification.Destination)
 	return Cargo{
 		TrackingID:           string(c.TrackingID),
 		Origin:               string(c.Origin),
 		Destination:          string(c.RouteSpecification.Destination),
-		ETA:                  c.Delivery.ETA,
+		ETA:                  inZone(c.Delivery.ETA, tz),
 		NextExpectedActivity: nextExpectedActivity(c),
-		ArrivalDeadline:      c.RouteSpecification.Deadline,
+		ArrivalDeadline:      inZone(c.RouteSpecification.Deadline, tz),
+		TimeZone:             tz.String(),
 		StatusText:           assembleStatusText(c),
-		Events:               assembleEvents(c, events),
+		Events:               assembleEvents(c, events, z),
 	}
 }
 
+// inZone returns t in the time zone, leaving zero times as they are so that
+// they are still recognized as unknown.
+func inZone(t time.Time, tz *time.Location) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return t.In(tz)
+}
+
 func assembleLegs(c cargo.Cargo) []Leg {
 	var legs []Leg
 	for _, l := range c.Itinerary.Legs {
@@ -129,33 +285,40 @@ func assembleStatusText(c *cargo.Cargo) string {
 	}
 }
 
-func assembleEvents(c *cargo.Cargo, handlingEvents cargo.HandlingEventRepository) []Event {
+func assembleEvents(c *cargo.Cargo, handlingEvents cargo.HandlingEventRepository, z zones) []Event {
 	h := handlingEvents.QueryHandlingHistory(c.TrackingID)
 
 	var events []Event
 	for _, e := range h.HandlingEvents {
 		var description string
 
+		tz := z.of(e.Activity.Location)
+		completed := inZone(e.Completed, tz)
+
 		switch e.Activity.Type {
 		case cargo.NotHandled:
 			description = "Cargo has not yet been received."
 		case cargo.Receive:
-			description = fmt.Sprintf("Received in %s, at %s", e.Activity.Location, time.Now().Format(time.RFC3339))
+			description = fmt.Sprintf("Received in %s, at %s", e.Activity.Location, completed.Format(time.RFC3339))
 		case cargo.Load:
-			description = fmt.Sprintf("Loaded onto voyage %s in %s, at %s.", e.Activity.VoyageNumber, e.Activity.Location, time.Now().Format(time.RFC3339))
+			description = fmt.Sprintf("Loaded onto voyage %s in %s, at %s.", e.Activity.VoyageNumber, e.Activity.Location, completed.Format(time.RFC3339))
 		case cargo.Unload:
-			description = fmt.Sprintf("Unloaded off voyage %s in %s, at %s.", e.Activity.VoyageNumber, e.Activity.Location, time.Now().Format(time.RFC3339))
+			description = fmt.Sprintf("Unloaded off voyage %s in %s, at %s.", e.Activity.VoyageNumber, e.Activity.Location, completed.Format(time.RFC3339))
 		case cargo.Claim:
-			description = fmt.Sprintf("Claimed in %s, at %s.", e.Activity.Location, time.Now().Format(time.RFC3339))
+			description = fmt.Sprintf("Claimed in %s, at %s.", e.Activity.Location, completed.Format(time.RFC3339))
 		case cargo.Customs:
-			description = fmt.Sprintf("Cleared customs in %s, at %s.", e.Activity.Location, time.Now().Format(time.RFC3339))
+			description = fmt.Sprintf("Cleared customs in %s, at %s.", e.Activity.Location, completed.Format(time.RFC3339))
 		default:
 			description = "[Unknown status]"
 		}
 
 		events = append(events, Event{
-			Description: description,
-			Expected:    c.Itinerary.IsExpected(e),
+			Description:      description,
+			Expected:         c.Itinerary.IsExpected(e),
+			Location:         string(e.Activity.Location),
+			CompletionTime:   completed,
+			RegistrationTime: inZone(e.Registered, tz),
+			TimeZone:         tz.String(),
 		})
 	}
 
@@ -1,42 +1,76 @@
 package voyage
 
 import (
+	"time"
+
 	"github.com/Qalifah/shipping/location"
 )
 
+// scheduleStart anchors the sample schedules, so that they always lie in the
+// near future.
+var scheduleStart = time.Now().UTC().Truncate(24 * time.Hour)
+
+// at returns the time of the given day and hour of the sample schedules.
+func at(day, hour int) time.Time {
+	return scheduleStart.Add(time.Duration(day*24+hour) * time.Hour)
+}
+
 // A set of sample voyages.
 var (
 	V100 = New("V100", Schedule{
 		[]CarrierMovement{
-			{DepartureLocation: location.CNHKG, ArrivalLocation: location.JNTKO},
-			{DepartureLocation: location.JNTKO, ArrivalLocation: location.USNYC},
+			{DepartureLocation: location.CNHKG, ArrivalLocation: location.JNTKO, DepartureTime: at(1, 8), ArrivalTime: at(3, 16)},
+			{DepartureLocation: location.JNTKO, ArrivalLocation: location.USNYC, DepartureTime: at(4, 8), ArrivalTime: at(14, 12)},
 		},
 	})
 
 	V300 = New("V300", Schedule{
 		[]CarrierMovement{
-			{DepartureLocation: location.JNTKO, ArrivalLocation: location.NLRTM},
-			{DepartureLocation: location.NLRTM, ArrivalLocation: location.DEHAM},
-			{DepartureLocation: location.DEHAM, ArrivalLocation: location.AUMEL},
-			{DepartureLocation: location.AUMEL, ArrivalLocation: location.JNTKO},
+			{DepartureLocation: location.JNTKO, ArrivalLocation: location.NLRTM, DepartureTime: at(2, 8), ArrivalTime: at(16, 14)},
+			{DepartureLocation: location.NLRTM, ArrivalLocation: location.DEHAM, DepartureTime: at(17, 8), ArrivalTime: at(18, 6)},
+			{DepartureLocation: location.DEHAM, ArrivalLocation: location.AUMEL, DepartureTime: at(19, 8), ArrivalTime: at(38, 20)},
+			{DepartureLocation: location.AUMEL, ArrivalLocation: location.JNTKO, DepartureTime: at(40, 8), ArrivalTime: at(49, 10)},
 		},
 	})
 
 	V400 = New("V400", Schedule{
 		[]CarrierMovement{
-			{DepartureLocation: location.DEHAM, ArrivalLocation: location.SESTO},
-			{DepartureLocation: location.SESTO, ArrivalLocation: location.FIHEL},
-			{DepartureLocation: location.FIHEL, ArrivalLocation: location.DEHAM},
+			{DepartureLocation: location.DEHAM, ArrivalLocation: location.SESTO, DepartureTime: at(1, 8), ArrivalTime: at(2, 20)},
+			{DepartureLocation: location.SESTO, ArrivalLocation: location.FIHEL, DepartureTime: at(3, 8), ArrivalTime: at(3, 20)},
+			{DepartureLocation: location.FIHEL, ArrivalLocation: location.DEHAM, DepartureTime: at(4, 8), ArrivalTime: at(6, 6)},
 		},
 	})
 )
 
-// These voyages are hard-coded into the current pathfinder. Make sure
+// These voyages are hard-coded into the legacy external pathfinder. Make sure
 // they exist.
 var (
-	V0100S = New("0100S", Schedule{[]CarrierMovement{}})
-	V0200T = New("0200T", Schedule{[]CarrierMovement{}})
-	V0300A = New("0300A", Schedule{[]CarrierMovement{}})
-	V0301S = New("0301S", Schedule{[]CarrierMovement{}})
-	V0400S = New("0400S", Schedule{[]CarrierMovement{}})
-)
\ No newline at end of file
+	V0100S = New("0100S", Schedule{
+		[]CarrierMovement{
+			{DepartureLocation: location.SESTO, ArrivalLocation: location.DEHAM, DepartureTime: at(1, 6), ArrivalTime: at(2, 10)},
+			{DepartureLocation: location.DEHAM, ArrivalLocation: location.NLRTM, DepartureTime: at(2, 18), ArrivalTime: at(3, 12)},
+		},
+	})
+	V0200T = New("0200T", Schedule{
+		[]CarrierMovement{
+			{DepartureLocation: location.NLRTM, ArrivalLocation: location.CNHKG, DepartureTime: at(4, 8), ArrivalTime: at(12, 18)},
+		},
+	})
+	V0300A = New("0300A", Schedule{
+		[]CarrierMovement{
+			{DepartureLocation: location.AUMEL, ArrivalLocation: location.CNHKG, DepartureTime: at(1, 12), ArrivalTime: at(4, 6)},
+			{DepartureLocation: location.CNHKG, ArrivalLocation: location.JNTKO, DepartureTime: at(4, 18), ArrivalTime: at(6, 8)},
+		},
+	})
+	V0301S = New("0301S", Schedule{
+		[]CarrierMovement{
+			{DepartureLocation: location.CNHKG, ArrivalLocation: location.NLRTM, DepartureTime: at(5, 8), ArrivalTime: at(13, 20)},
+			{DepartureLocation: location.NLRTM, ArrivalLocation: location.SESTO, DepartureTime: at(14, 8), ArrivalTime: at(16, 8)},
+		},
+	})
+	V0400S = New("0400S", Schedule{
+		[]CarrierMovement{
+			{DepartureLocation: location.USNYC, ArrivalLocation: location.USCHI, DepartureTime: at(15, 8), ArrivalTime: at(17, 8)},
+		},
+	})
+)
@@ -39,5 +39,7 @@ var ErrUnknown = errors.New("unknown voyage")
 
 // Repository provides access to a voyage store
 type Repository interface {
+	Store(*Voyage) error
 	Find(Number) (*Voyage, error)
+	FindAll() []*Voyage
 }